@@ -0,0 +1,78 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/jessevdk/go-flags"
+	"github.com/pashagolub/confelo/pkg/data"
+)
+
+// exportCommand is the first argument that selects the export command
+const exportCommand = "export"
+
+// executeExport writes a session's ranking to a new file without starting the interface
+func executeExport(args []string) error {
+	options, err := data.ParseExportCLI(args)
+	if err != nil {
+		if flagsErr, ok := err.(*flags.Error); ok && flagsErr.Type == flags.ErrHelp {
+			return nil
+		}
+		return &CLIError{
+			Code:    ExitUsageError,
+			Message: fmt.Sprintf("Invalid export arguments: %v", err),
+			Suggestions: []string{
+				"Usage: confelo export --session-name NAME --output ranked.csv [--format csv|json|yaml|markdown|html]",
+			},
+		}
+	}
+
+	detector := data.NewSessionDetector("sessions")
+	sessionFile, err := detector.FindSessionFile(options.SessionName)
+	if err != nil || sessionFile == "" {
+		return sessionNotAvailableError(options.SessionName, data.ErrSessionNotFound)
+	}
+
+	storage := &data.FileStorage{}
+	session, err := storage.LoadSession(sessionFile)
+	if err != nil {
+		return handleSessionLoadError(err, options.SessionName, sessionFile)
+	}
+
+	// The input CSV is the source of truth and must never be replaced by an export
+	if sameFile(options.Output, session.InputCSVPath) {
+		return &CLIError{
+			Code:    ExitValidationError,
+			Message: fmt.Sprintf("Output file %s is the session's input CSV", options.Output),
+			Suggestions: []string{
+				"Choose a different --output file",
+			},
+		}
+	}
+
+	format := options.FormatFor(session.Config.Export.Format)
+	if err := data.ExportRankings(session, options.Output, format); err != nil {
+		return &CLIError{
+			Code:    ExitExportError,
+			Message: fmt.Sprintf("Failed to export rankings: %v", err),
+			Details: map[string]any{
+				"output": options.Output,
+				"format": format,
+			},
+		}
+	}
+
+	fmt.Printf("Exported %d proposals from '%s' to %s (%s)\n",
+		len(session.Proposals), session.Name, options.Output, format)
+	return nil
+}
+
+// sameFile reports whether two paths refer to the same file location
+func sameFile(a, b string) bool {
+	if a == "" || b == "" {
+		return false
+	}
+	absA, errA := filepath.Abs(a)
+	absB, errB := filepath.Abs(b)
+	return errA == nil && errB == nil && absA == absB
+}
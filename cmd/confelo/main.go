@@ -82,6 +82,20 @@ func main() {
 }
 
 func run() error {
+	// The merge, export, sessions and simulate commands have their own options
+	if len(os.Args) > 1 && os.Args[1] == mergeCommand {
+		return executeMerge(os.Args[2:])
+	}
+	if len(os.Args) > 1 && os.Args[1] == exportCommand {
+		return executeExport(os.Args[2:])
+	}
+	if len(os.Args) > 1 && os.Args[1] == sessionsCommand {
+		return executeSessions(os.Args[2:])
+	}
+	if len(os.Args) > 1 && os.Args[1] == simulateCommand {
+		return executeSimulate(os.Args[2:])
+	}
+
 	// Use the standardized CLI parsing from data package
 	options, err := data.ParseCLI(os.Args[1:])
 	if err != nil {
@@ -131,22 +145,12 @@ func executeWithAutomaticModeDetection(options *data.CLIOptions) error {
 		fmt.Printf("Mode detected: %s for session '%s'\n", mode, options.SessionName)
 	}
 
-	// Convert SimplifiedCLIOptions to data.CLIOptions
-	cliOptions := &data.CLIOptions{
-		SessionName:    options.SessionName,
-		Input:          options.Input,
-		ComparisonMode: options.ComparisonMode,
-		InitialRating:  options.InitialRating,
-		OutputScale:    options.OutputScale,
-		TargetAccepted: options.TargetAccepted,
-	}
-
 	// Handle mode-specific logic
 	switch mode {
 	case data.StartMode:
-		return executeStartMode(cliOptions, options.Verbose)
+		return executeStartMode(options, options.Verbose)
 	case data.ResumeMode:
-		return executeResumeMode(cliOptions, options.Verbose)
+		return executeResumeMode(options, options.Verbose)
 	default:
 		return &CLIError{
 			Code:    ExitImplementationError,
@@ -260,8 +264,12 @@ func executeStartMode(options *data.CLIOptions, verbose bool) error {
 		}
 	}
 
+	// Attribute comparisons of this run to the reviewer and apply their conflicts
+	session.SetReviewer(options.Reviewer)
+	session.SetConflicts(data.ParseConflictTags(options.Conflicts))
+
 	// Launch TUI in interactive mode
-	return runInteractiveMode(session, config, storage)
+	return launchInteractiveMode(session, config, storage, options.Triage)
 }
 
 // executeResumeMode handles resuming an existing session
@@ -319,13 +327,56 @@ func executeResumeMode(options *data.CLIOptions, verbose bool) error {
 		}
 	}
 
+	// The rating engine is fixed when the session is created
+	for _, flag := range []string{"k-factor", "min-rating", "max-rating", "rating-system"} {
+		if options.IsSet(flag) {
+			return &CLIError{
+				Code:    ExitValidationError,
+				Message: fmt.Sprintf("--%s cannot be changed when resuming session '%s'", flag, options.SessionName),
+				Suggestions: []string{
+					fmt.Sprintf("Resume without --%s to keep the session's rating settings", flag),
+					"Use a different session name to start a new session with these settings",
+				},
+			}
+		}
+	}
+
+	// The output scale can be changed; otherwise exports keep the session's scale
+	if options.IsSet("output-scale") {
+		session.SetOutputScale(config.Elo.OutputMin, config.Elo.OutputMax, config.Elo.UseDecimals)
+	}
+	config.Elo = session.Config.Elo
+
+	// Grouping can be declared or changed when resuming a session
+	if options.GroupBy != "" {
+		session.SetGroupConfig(config.Groups)
+	}
+
+	// Decision bands can be changed too; a band not given keeps the session's value
+	targetAccepted, waitlistSize := session.GetDecisionBands()
+	if options.IsSet("target-accepted") || options.IsSet("waitlist") {
+		if options.IsSet("target-accepted") {
+			targetAccepted = options.TargetAccepted
+		}
+		if options.IsSet("waitlist") {
+			waitlistSize = options.Waitlist
+		}
+		session.SetDecisionBands(targetAccepted, waitlistSize)
+	}
+	config.Convergence.TargetAccepted = targetAccepted
+	config.Convergence.WaitlistSize = waitlistSize
+
 	if verbose {
 		fmt.Printf("Loaded session: %s\n", session.Name)
 		fmt.Printf("Session config: comparison=%s, rating=%.1f\n", config.UI.ComparisonMode, config.Elo.InitialRating)
 	}
 
+	// Attribute comparisons of this run to the reviewer and apply their conflicts
+	session.SetReviewer(options.Reviewer)
+	session.SetConflicts(data.ParseConflictTags(options.Conflicts))
+
 	// Launch TUI in interactive mode
-	return runInteractiveMode(session, config, storage)
+	return launchInteractiveMode(session, config, storage, options.Triage)
 }
 
 // Helper functions
@@ -337,13 +388,21 @@ func showVersion() error {
 	return nil
 }
 
-func runInteractiveMode(session *data.Session, config *data.SessionConfig, storage data.Storage) error {
+// launchInteractiveMode starts the TUI for a started or resumed session (replaced in tests)
+var launchInteractiveMode = runInteractiveMode
+
+func runInteractiveMode(session *data.Session, config *data.SessionConfig, storage data.Storage, triage bool) error {
 	// Import TUI components - we need to add these imports at the top
 	tuiApp, err := createTUIApp(session, config, storage)
 	if err != nil {
 		return fmt.Errorf("failed to create TUI application: %w", err)
 	}
 
+	// Start with the triage pass when requested
+	if triage {
+		tuiApp.SetStartScreen(tui.ScreenTriage)
+	}
+
 	// Start the TUI application
 	runErr := tuiApp.Run()
 
@@ -372,6 +431,7 @@ func createTUIApp(session *data.Session, config *data.SessionConfig, storage dat
 	// Create and register screens
 	comparisonScreen := screens.NewComparisonScreen()
 	rankingScreen := screens.NewRankingScreen()
+	triageScreen := screens.NewTriageScreen()
 
 	// Register screens with the app
 	if err := app.RegisterScreen(tui.ScreenComparison, comparisonScreen); err != nil {
@@ -380,6 +440,9 @@ func createTUIApp(session *data.Session, config *data.SessionConfig, storage dat
 	if err := app.RegisterScreen(tui.ScreenRanking, rankingScreen); err != nil {
 		return nil, fmt.Errorf("failed to register ranking screen: %w", err)
 	}
+	if err := app.RegisterScreen(tui.ScreenTriage, triageScreen); err != nil {
+		return nil, fmt.Errorf("failed to register triage screen: %w", err)
+	}
 
 	return app, nil
 }
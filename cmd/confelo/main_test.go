@@ -0,0 +1,122 @@
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pashagolub/confelo/pkg/data"
+)
+
+// stubInteractiveMode replaces the TUI launch for the test and returns the sessions and
+// triage flags it was called with
+func stubInteractiveMode(t *testing.T) (*[]*data.Session, *[]bool) {
+	t.Helper()
+
+	sessions := make([]*data.Session, 0)
+	triage := make([]bool, 0)
+	launchInteractiveMode = func(session *data.Session, _ *data.SessionConfig, _ data.Storage, startWithTriage bool) error {
+		sessions = append(sessions, session)
+		triage = append(triage, startWithTriage)
+		return nil
+	}
+	t.Cleanup(func() { launchInteractiveMode = runInteractiveMode })
+	return &sessions, &triage
+}
+
+// runCLI parses the arguments and runs the start or resume mode they select
+func runCLI(t *testing.T, args ...string) error {
+	t.Helper()
+
+	options, err := data.ParseCLI(args)
+	require.NoError(t, err)
+	return executeWithAutomaticModeDetection(options)
+}
+
+func TestExecuteWithAutomaticModeDetection_Triage(t *testing.T) {
+	t.Chdir(t.TempDir())
+	require.NoError(t, os.WriteFile("proposals.csv", []byte("id,title,speaker\np1,Alpha,Ann\np2,Beta,Bob\n"), 0644))
+	sessions, triage := stubInteractiveMode(t)
+
+	// Start mode
+	require.NoError(t, runCLI(t, "--session-name", "triage", "--input", "proposals.csv", "--triage"))
+	_, err := os.Stat("sessions/triage.json")
+	require.NoError(t, err)
+
+	// Resume mode
+	require.NoError(t, runCLI(t, "--session-name", "triage"))
+	require.NoError(t, runCLI(t, "--session-name", "triage", "--triage"))
+
+	assert.Equal(t, []bool{true, false, true}, *triage)
+	for _, session := range *sessions {
+		assert.Equal(t, "triage", session.Name)
+	}
+}
+
+func TestExecuteResumeMode_DecisionBands(t *testing.T) {
+	t.Chdir(t.TempDir())
+	require.NoError(t, os.WriteFile("proposals.csv", []byte("id,title,speaker\np1,Alpha,Ann\np2,Beta,Bob\n"), 0644))
+	sessions, _ := stubInteractiveMode(t)
+
+	require.NoError(t, runCLI(t, "--session-name", "bands", "--input", "proposals.csv", "--target-accepted", "20", "--waitlist", "8"))
+
+	// Resuming without the flags keeps the session's bands
+	require.NoError(t, runCLI(t, "--session-name", "bands"))
+	targetAccepted, waitlistSize := (*sessions)[1].GetDecisionBands()
+	assert.Equal(t, 20, targetAccepted)
+	assert.Equal(t, 8, waitlistSize)
+
+	// A band given on resume replaces the session's value, the other one is kept
+	require.NoError(t, runCLI(t, "--session-name", "bands", "--waitlist", "0"))
+	targetAccepted, waitlistSize = (*sessions)[2].GetDecisionBands()
+	assert.Equal(t, 20, targetAccepted)
+	assert.Equal(t, 0, waitlistSize)
+}
+
+func TestExecuteResumeMode_RatingSettings(t *testing.T) {
+	t.Chdir(t.TempDir())
+	require.NoError(t, os.WriteFile("proposals.csv", []byte("id,title,speaker,score\np1,Alpha,Ann,\np2,Beta,Bob,\n"), 0644))
+
+	var resumed *data.Session
+	var resumedConfig *data.SessionConfig
+	var resumedStorage data.Storage
+	launchInteractiveMode = func(session *data.Session, config *data.SessionConfig, storage data.Storage, _ bool) error {
+		resumed, resumedConfig, resumedStorage = session, config, storage
+		return nil
+	}
+	t.Cleanup(func() { launchInteractiveMode = runInteractiveMode })
+
+	require.NoError(t, runCLI(t, "--session-name", "bounds", "--input", "proposals.csv",
+		"--initial-rating", "1200", "--min-rating", "1000", "--max-rating", "2000"))
+
+	// The rating engine cannot be changed on resume
+	for _, flag := range []string{"--k-factor=16", "--min-rating=500", "--max-rating=2500", "--rating-system=glicko2"} {
+		err := runCLI(t, "--session-name", "bounds", flag)
+		var cliErr *CLIError
+		require.ErrorAs(t, err, &cliErr, flag)
+		assert.Equal(t, ExitValidationError, cliErr.Code, flag)
+	}
+
+	// Resuming keeps the session's bounds, so the export scales 1200 within 1000-2000
+	require.NoError(t, runCLI(t, "--session-name", "bounds"))
+	require.NotNil(t, resumed)
+	assert.Equal(t, 1000.0, resumedConfig.Elo.MinRating)
+	assert.Equal(t, 2000.0, resumedConfig.Elo.MaxRating)
+
+	app, err := createTUIApp(resumed, resumedConfig, resumedStorage)
+	require.NoError(t, err)
+	require.NoError(t, app.ExportToCSV())
+
+	content, err := os.ReadFile("proposals.csv")
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "p1,Alpha,Ann,20")
+	assert.Contains(t, string(content), "p2,Beta,Bob,20")
+
+	// The output scale can be changed on resume
+	require.NoError(t, runCLI(t, "--session-name", "bounds", "--output-scale", "1-5"))
+	assert.Equal(t, 1.0, resumed.Config.Elo.OutputMin)
+	assert.Equal(t, 5.0, resumed.Config.Elo.OutputMax)
+	assert.Equal(t, resumed.Config.Elo, resumedConfig.Elo)
+}
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/jessevdk/go-flags"
+	"github.com/pashagolub/confelo/pkg/data"
+)
+
+// mergeCommand is the first argument that selects the merge command
+const mergeCommand = "merge"
+
+// executeMerge combines independent reviewer sessions into one consensus session
+func executeMerge(args []string) error {
+	options, err := data.ParseMergeCLI(args)
+	if err != nil {
+		if flagsErr, ok := err.(*flags.Error); ok && flagsErr.Type == flags.ErrHelp {
+			return nil
+		}
+		return &CLIError{
+			Code:    ExitUsageError,
+			Message: fmt.Sprintf("Invalid merge arguments: %v", err),
+			Suggestions: []string{
+				"Usage: confelo merge --sessions a,b,c --output consensus",
+			},
+		}
+	}
+
+	sessionsDir := "sessions"
+	detector := data.NewSessionDetector(sessionsDir)
+	storage := &data.FileStorage{}
+
+	// Refuse to overwrite an existing session
+	existing, err := detector.FindSessionFile(options.Output)
+	if err != nil {
+		return &CLIError{
+			Code:    ExitSessionError,
+			Message: fmt.Sprintf("Failed to check output session: %v", err),
+		}
+	}
+	if existing != "" {
+		return &CLIError{
+			Code:    ExitValidationError,
+			Message: fmt.Sprintf("Session '%s' already exists", options.Output),
+			Suggestions: []string{
+				"Choose a different --output name",
+				fmt.Sprintf("Delete %s to recreate the consensus session", existing),
+			},
+		}
+	}
+
+	// Load all source sessions
+	sources := make([]*data.Session, 0, len(options.SessionNames()))
+	for _, name := range options.SessionNames() {
+		sessionFile, err := detector.FindSessionFile(name)
+		if err != nil || sessionFile == "" {
+			return &CLIError{
+				Code:    ExitSessionError,
+				Message: fmt.Sprintf("Session '%s' not found", name),
+				Suggestions: []string{
+					"Check the session names passed to --sessions",
+					"Copy reviewer session files into the sessions/ directory",
+				},
+			}
+		}
+
+		session, err := storage.LoadSession(sessionFile)
+		if err != nil {
+			return handleSessionLoadError(err, name, sessionFile)
+		}
+		sources = append(sources, session)
+	}
+
+	merged, err := data.NewMergedSession(options.Output, sources)
+	if err != nil {
+		code := ExitSessionError
+		if errors.Is(err, data.ErrMergeMismatch) {
+			code = ExitValidationError
+		}
+		return &CLIError{
+			Code:    code,
+			Message: fmt.Sprintf("Failed to merge sessions: %v", err),
+			Suggestions: []string{
+				"Merge only sessions created from the same proposals CSV",
+			},
+		}
+	}
+
+	comparisons := data.MergeComparisons(sources)
+	if err := merged.ReplayComparisons(comparisons); err != nil {
+		return &CLIError{
+			Code:    ExitSessionError,
+			Message: fmt.Sprintf("Failed to replay comparisons: %v", err),
+		}
+	}
+
+	if err := os.MkdirAll(sessionsDir, 0755); err != nil {
+		return &CLIError{
+			Code:    ExitSessionError,
+			Message: fmt.Sprintf("Failed to create sessions directory: %v", err),
+		}
+	}
+
+	sessionFile := filepath.Join(sessionsDir, data.SanitizeFilename(options.Output)+".json")
+	if err := storage.SaveSession(merged, sessionFile); err != nil {
+		return &CLIError{
+			Code:    ExitSessionError,
+			Message: fmt.Sprintf("Failed to save session: %v", err),
+		}
+	}
+
+	fmt.Printf("Merged %d sessions (%d comparisons) into '%s' (file: %s)\n",
+		len(sources), len(comparisons), options.Output, filepath.Base(sessionFile))
+	if options.Verbose {
+		for _, source := range sources {
+			fmt.Printf("  - %s: %d comparisons\n", source.Name, len(source.GetComparisonHistory()))
+		}
+	}
+
+	printDisagreements(sources, data.FindDisagreements(merged, sources, options.Top))
+
+	return nil
+}
+
+// printDisagreements prints the proposals ranked most differently by the source sessions
+func printDisagreements(sources []*data.Session, disagreements []data.ReviewerDisagreement) {
+	names := make([]string, 0, len(sources))
+	for _, source := range sources {
+		names = append(names, source.Name)
+	}
+	sort.Strings(names)
+
+	fmt.Printf("\nLargest reviewer disagreements (rank per session):\n")
+	fmt.Printf("  %-6s %-6s %-40s %s\n", "Rank", "Spread", "Title", strings.Join(names, " | "))
+
+	for _, disagreement := range disagreements {
+		ranks := make([]string, len(names))
+		for i, name := range names {
+			ranks[i] = fmt.Sprintf("%d", disagreement.Ranks[name])
+		}
+
+		fmt.Printf("  %-6d %-6d %-40s %s\n", disagreement.ConsensusRank, disagreement.Spread,
+			truncateTitle(disagreement.Title, 40), strings.Join(ranks, " | "))
+	}
+}
+
+// truncateTitle shortens a title to at most width characters, ending cut titles with "..."
+func truncateTitle(title string, width int) string {
+	runes := []rune(title)
+	if len(runes) <= width {
+		return title
+	}
+	return string(runes[:width-3]) + "..."
+}
@@ -0,0 +1,14 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTruncateTitle(t *testing.T) {
+	assert.Equal(t, "Short title", truncateTitle("Short title", 12))
+	assert.Equal(t, "Exactly12chr", truncateTitle("Exactly12chr", 12))
+	assert.Equal(t, "Long tit...", truncateTitle("Long titles are cut", 11))
+	assert.Equal(t, "Über die Äh...", truncateTitle("Über die Ähnlichkeit", 14))
+}
@@ -0,0 +1,231 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/jessevdk/go-flags"
+	"github.com/pashagolub/confelo/pkg/data"
+)
+
+// sessionsCommand is the first argument that selects the session management command
+const sessionsCommand = "sessions"
+
+// sessionListEntry is a listed session; unreadable sessions carry an error instead of details
+type sessionListEntry struct {
+	data.SessionInfo
+	Error string `json:"error,omitempty"`
+}
+
+// sessionValidation is the validation result of a single session
+type sessionValidation struct {
+	Name  string `json:"name"`
+	Valid bool   `json:"valid"`
+	Error string `json:"error,omitempty"`
+}
+
+// executeSessions lists, inspects, deletes or validates sessions without starting the interface
+func executeSessions(args []string) error {
+	options, err := data.ParseSessionsCLI(args)
+	if err != nil {
+		if flagsErr, ok := err.(*flags.Error); ok && flagsErr.Type == flags.ErrHelp {
+			return nil
+		}
+		return &CLIError{
+			Code:    ExitUsageError,
+			Message: fmt.Sprintf("Invalid sessions arguments: %v", err),
+			Suggestions: []string{
+				"Usage: confelo sessions list|info|delete|validate [--session-name NAME] [--json]",
+			},
+		}
+	}
+
+	sessionsDir := "sessions"
+	switch options.Action {
+	case data.SessionsList:
+		return listSessions(sessionsDir, options)
+	case data.SessionsInfo:
+		return showSessionInfo(sessionsDir, options)
+	case data.SessionsDelete:
+		return deleteSession(sessionsDir, options)
+	default:
+		return validateSessions(sessionsDir, options)
+	}
+}
+
+// listSessions prints a summary line for every session in the sessions directory
+func listSessions(sessionsDir string, options *data.SessionsOptions) error {
+	names, err := data.ListSessions(sessionsDir)
+	if err != nil {
+		return &CLIError{
+			Code:    ExitFileError,
+			Message: fmt.Sprintf("Failed to list sessions: %v", err),
+		}
+	}
+
+	entries := make([]sessionListEntry, 0, len(names))
+	for _, name := range names {
+		info, err := data.GetSessionInfo(name, sessionsDir)
+		if err != nil {
+			entries = append(entries, sessionListEntry{SessionInfo: data.SessionInfo{Name: name}, Error: err.Error()})
+			continue
+		}
+		entries = append(entries, sessionListEntry{SessionInfo: *info})
+	}
+
+	if options.JSON {
+		return printJSON(map[string]any{"sessions": entries})
+	}
+
+	if len(entries) == 0 {
+		fmt.Printf("No sessions found in %s/\n", sessionsDir)
+		return nil
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "NAME\tSTATUS\tPROPOSALS\tCOMPARISONS\tUPDATED")
+	for _, entry := range entries {
+		if entry.Error != "" {
+			fmt.Fprintf(writer, "%s\tunreadable\t-\t-\t%s\n", entry.Name, entry.Error)
+			continue
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%d\t%d\t%s\n", entry.Name, entry.Status, entry.ProposalCount,
+			entry.ComparisonCount, entry.UpdatedAt.Local().Format("2006-01-02 15:04"))
+	}
+	return writer.Flush()
+}
+
+// showSessionInfo prints the details of a single session
+func showSessionInfo(sessionsDir string, options *data.SessionsOptions) error {
+	info, err := data.GetSessionInfo(options.SessionName, sessionsDir)
+	if err != nil {
+		return sessionNotAvailableError(options.SessionName, err)
+	}
+
+	if options.JSON {
+		return printJSON(info)
+	}
+
+	fmt.Printf("Session:      %s\n", info.Name)
+	fmt.Printf("Status:       %s\n", info.Status)
+	fmt.Printf("Input CSV:    %s\n", info.InputCSVPath)
+	fmt.Printf("Proposals:    %d\n", info.ProposalCount)
+	fmt.Printf("Comparisons:  %d\n", info.ComparisonCount)
+	fmt.Printf("Created:      %s\n", info.CreatedAt.Local().Format("2006-01-02 15:04:05"))
+	fmt.Printf("Updated:      %s\n", info.UpdatedAt.Local().Format("2006-01-02 15:04:05"))
+	fmt.Printf("File size:    %d bytes\n", info.FileSize)
+	return nil
+}
+
+// deleteSession removes a session file together with its history log and backups
+func deleteSession(sessionsDir string, options *data.SessionsOptions) error {
+	if _, err := data.GetSessionInfo(options.SessionName, sessionsDir); errors.Is(err, data.ErrSessionNotFound) {
+		return sessionNotAvailableError(options.SessionName, err)
+	}
+
+	if err := data.DeleteSession(options.SessionName, sessionsDir); err != nil {
+		return &CLIError{
+			Code:    ExitFileError,
+			Message: fmt.Sprintf("Failed to delete session '%s': %v", options.SessionName, err),
+		}
+	}
+
+	if options.JSON {
+		return printJSON(map[string]any{"deleted": options.SessionName})
+	}
+
+	fmt.Printf("Deleted session '%s'\n", options.SessionName)
+	return nil
+}
+
+// validateSessions validates one session, or all sessions when no name is given
+func validateSessions(sessionsDir string, options *data.SessionsOptions) error {
+	names := []string{options.SessionName}
+	if options.SessionName == "" {
+		var err error
+		if names, err = data.ListSessions(sessionsDir); err != nil {
+			return &CLIError{
+				Code:    ExitFileError,
+				Message: fmt.Sprintf("Failed to list sessions: %v", err),
+			}
+		}
+	}
+
+	results := make([]sessionValidation, 0, len(names))
+	invalid := make([]string, 0)
+	for _, name := range names {
+		result := sessionValidation{Name: name, Valid: true}
+		if err := data.ValidateSessionFile(name, sessionsDir); err != nil {
+			result.Valid = false
+			result.Error = err.Error()
+			invalid = append(invalid, name)
+		}
+		results = append(results, result)
+	}
+
+	if options.JSON {
+		if err := printJSON(map[string]any{"results": results}); err != nil {
+			return err
+		}
+	} else {
+		for _, result := range results {
+			if result.Valid {
+				fmt.Printf("OK       %s\n", result.Name)
+			} else {
+				fmt.Printf("INVALID  %s: %s\n", result.Name, result.Error)
+			}
+		}
+	}
+
+	if len(invalid) > 0 {
+		return &CLIError{
+			Code:    ExitValidationError,
+			Message: fmt.Sprintf("%d of %d sessions failed validation", len(invalid), len(results)),
+			Details: map[string]any{
+				"invalid_sessions": invalid,
+			},
+			Suggestions: []string{
+				"Restore the session from a backup or delete it with 'confelo sessions delete'",
+			},
+		}
+	}
+
+	return nil
+}
+
+// sessionNotAvailableError reports a session that is missing or cannot be read
+func sessionNotAvailableError(sessionName string, err error) error {
+	if errors.Is(err, data.ErrSessionNotFound) {
+		return &CLIError{
+			Code:    ExitSessionError,
+			Message: fmt.Sprintf("Session '%s' not found", sessionName),
+			Suggestions: []string{
+				"Run 'confelo sessions list' to see available sessions",
+			},
+		}
+	}
+
+	return &CLIError{
+		Code:    ExitSessionError,
+		Message: fmt.Sprintf("Failed to read session '%s': %v", sessionName, err),
+		Suggestions: []string{
+			fmt.Sprintf("Run 'confelo sessions validate --session-name %s' for details", sessionName),
+		},
+	}
+}
+
+// printJSON writes a value as indented JSON to stdout
+func printJSON(value any) error {
+	jsonBytes, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return &CLIError{
+			Code:    ExitImplementationError,
+			Message: fmt.Sprintf("Failed to encode JSON output: %v", err),
+		}
+	}
+	fmt.Println(string(jsonBytes))
+	return nil
+}
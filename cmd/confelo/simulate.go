@@ -0,0 +1,130 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/jessevdk/go-flags"
+	"github.com/pashagolub/confelo/pkg/data"
+)
+
+// simulateCommand is the first argument that selects the simulate command
+const simulateCommand = "simulate"
+
+// simulationPoint is the accuracy after a number of comparisons in JSON output
+type simulationPoint struct {
+	Comparisons   int     `json:"comparisons"`
+	KendallTau    float64 `json:"kendall_tau"`
+	TopTPrecision float64 `json:"top_t_precision"`
+}
+
+// simulationReport is one simulated session in JSON output
+type simulationReport struct {
+	Strategy  string            `json:"strategy"`
+	Method    string            `json:"comparison_mode"`
+	KFactor   int               `json:"k_factor"`
+	Points    []simulationPoint `json:"points"`
+	StoppedAt int               `json:"stopped_at,omitempty"`
+	AtStop    *simulationPoint  `json:"at_stop,omitempty"`
+	Exhausted bool              `json:"exhausted,omitempty"`
+}
+
+// executeSimulate measures ranking accuracy of matchup strategies and K-factors against
+// a simulated reviewer with hidden true strengths for the proposals of a CSV
+func executeSimulate(args []string) error {
+	options, err := data.ParseSimulateCLI(args)
+	if err != nil {
+		if flagsErr, ok := err.(*flags.Error); ok && flagsErr.Type == flags.ErrHelp {
+			return nil
+		}
+		return &CLIError{
+			Code:    ExitUsageError,
+			Message: fmt.Sprintf("Invalid simulate arguments: %v", err),
+			Suggestions: []string{
+				"Usage: confelo simulate --input proposals.csv [--strategy information-gain,sequential] [--comparison-mode pairwise,trio,quartet] [--k-factor 16,32,48] [--json]",
+			},
+		}
+	}
+
+	settings := options.Settings()
+	storage := &data.FileStorage{}
+	parseResult, err := storage.LoadProposalsFromCSVWithElo(options.Input, data.DefaultCSVConfig(), &settings.Elo)
+	if err != nil {
+		return &CLIError{
+			Code:    ExitFileError,
+			Message: fmt.Sprintf("Failed to load CSV file: %v", err),
+		}
+	}
+
+	runs, err := data.SimulateProposals(parseResult.Proposals, settings)
+	if err != nil {
+		return &CLIError{
+			Code:    ExitValidationError,
+			Message: fmt.Sprintf("Simulation failed: %v", err),
+		}
+	}
+
+	if options.JSON {
+		reports := make([]simulationReport, len(runs))
+		for i, run := range runs {
+			reports[i] = newSimulationReport(run)
+		}
+		return printJSON(map[string]any{"proposals": len(parseResult.Proposals), "runs": reports})
+	}
+
+	fmt.Printf("Simulated %d proposals: noise %.0f, spread %.0f, top %d, seed %d\n\n",
+		len(parseResult.Proposals), settings.Noise, settings.Spread, settings.Convergence.TargetAccepted, settings.Seed)
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "STRATEGY\tMODE\tK\tCOMPARISONS\tKENDALL TAU\tTOP-T PRECISION\t")
+	for _, run := range runs {
+		stopMarked := false
+		for _, point := range run.Result.Points {
+			// Mark the first measurement taken once stopping was recommended
+			marker := ""
+			if !stopMarked && run.Result.StoppedAt > 0 && point.Comparisons >= run.Result.StoppedAt {
+				marker, stopMarked = "<- stop", true
+			}
+			fmt.Fprintf(writer, "%s\t%s\t%d\t%d\t%.3f\t%.2f\t%s\n", run.Strategy, run.Method, run.KFactor,
+				point.Comparisons, point.KendallTau, point.TopTPrecision, marker)
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Printf("\nRecommended stopping points:\n")
+	for _, run := range runs {
+		switch result := run.Result; {
+		case result.StoppedAt > 0:
+			fmt.Printf("  %s %s K=%d: after %d comparisons (tau %.3f, top-T precision %.2f)\n", run.Strategy, run.Method,
+				run.KFactor, result.StoppedAt, result.AtStop.KendallTau, result.AtStop.TopTPrecision)
+		case result.Exhausted:
+			fmt.Printf("  %s %s K=%d: strategy ran out of comparisons\n", run.Strategy, run.Method, run.KFactor)
+		default:
+			fmt.Printf("  %s %s K=%d: not reached\n", run.Strategy, run.Method, run.KFactor)
+		}
+	}
+	return nil
+}
+
+// newSimulationReport converts a simulation run for JSON output
+func newSimulationReport(run data.SimulationRun) simulationReport {
+	report := simulationReport{
+		Strategy:  run.Strategy,
+		Method:    string(run.Method),
+		KFactor:   run.KFactor,
+		Points:    make([]simulationPoint, len(run.Result.Points)),
+		StoppedAt: run.Result.StoppedAt,
+		Exhausted: run.Result.Exhausted,
+	}
+	for i, point := range run.Result.Points {
+		report.Points[i] = simulationPoint(point)
+	}
+	if run.Result.StoppedAt > 0 {
+		atStop := simulationPoint(run.Result.AtStop)
+		report.AtStop = &atStop
+	}
+	return report
+}
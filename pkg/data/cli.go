@@ -6,6 +6,7 @@ package data
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 
@@ -20,15 +21,35 @@ type CLIOptions struct {
 
 	// Optional configuration (required for new sessions, ignored for existing sessions)
 	Input          string  `long:"input" short:"i" description:"CSV file path (required for new sessions, ignored when resuming)"`
-	ComparisonMode string  `long:"comparison-mode" description:"Comparison method: pairwise, trio, or quartet" default:"pairwise"`
+	ComparisonMode string  `long:"comparison-mode" description:"Comparison method: pairwise, trio, quartet, pick-best (pick the best K of N) or rank-top (rank the top K of N)" default:"pairwise"`
+	GroupSize      int     `long:"group-size" description:"Proposals presented at once by pick-best and rank-top (3-8)" default:"6"`
+	PickCount      int     `long:"pick-count" description:"Proposals to pick or rank by pick-best and rank-top (fewer than --group-size)" default:"3"`
 	InitialRating  float64 `long:"initial-rating" description:"Starting Elo rating for new proposals" default:"1500.0"`
+	KFactor        int     `long:"k-factor" description:"Elo K-factor controlling rating sensitivity per comparison" default:"32"`
+	MinRating      float64 `long:"min-rating" description:"Lowest Elo rating a proposal can reach" default:"0"`
+	MaxRating      float64 `long:"max-rating" description:"Highest Elo rating a proposal can reach" default:"3000"`
 	OutputScale    string  `long:"output-scale" description:"Rating scale format (e.g., '0-100', '1.0-5.0')" default:"0-100"`
 	TargetAccepted int     `long:"target-accepted" short:"t" description:"Target number of proposals to accept" default:"10"`
+	Waitlist       int     `long:"waitlist" description:"Number of proposals after the accepted ones to put on the waitlist" default:"5"`
+	GroupBy        string  `long:"group-by" description:"CSV column to rank proposals by (e.g. 'track'); comparisons stay mostly within a group"`
+	Quota          string  `long:"quota" description:"Comma-separated proposals to accept per group (e.g. 'dev=6,ops=4,community=2'); requires --group-by"`
+	RatingSystem   string  `long:"rating-system" description:"Rating algorithm: elo or glicko2 (tracks rating deviation per proposal)" default:"elo"`
+	Reviewer       string  `long:"reviewer" description:"Reviewer name recorded on each comparison (for shared committee sessions)"`
+	Conflicts      string  `long:"conflicts" description:"Comma-separated conflict tags; proposals tagged with any of them are not shown to you (e.g. 'acme,speaker:Jane Doe')"`
+	Triage         bool    `long:"triage" description:"Start with a quick strong/maybe/weak triage pass before comparing"`
 
 	// Global options
 	Verbose bool `long:"verbose" short:"v" description:"Enable detailed logging output"`
 	Version bool `long:"version" description:"Show version and build information"`
 	Help    bool `long:"help" short:"h" description:"Show this help message"`
+
+	// Long names of the flags given on the command line (not taken from defaults)
+	setFlags map[string]bool
+}
+
+// IsSet reports whether a flag was given on the command line, by its long name
+func (opts *CLIOptions) IsSet(longName string) bool {
+	return opts.setFlags[longName]
 }
 
 // ParseCLI parses the simplified command-line arguments and returns CLI options
@@ -48,6 +69,16 @@ func ParseCLI(args []string) (*CLIOptions, error) {
 		return nil, fmt.Errorf("failed to parse command-line arguments: %w", err)
 	}
 
+	// Remember which flags were given; resuming a session applies only those
+	opts.setFlags = make(map[string]bool)
+	for _, group := range parser.Groups() {
+		for _, option := range group.Options() {
+			if option.IsSet() && !option.IsSetDefault() {
+				opts.setFlags[option.LongName] = true
+			}
+		}
+	}
+
 	// Handle version flag (before validation)
 	if opts.Version {
 		return &opts, nil
@@ -78,10 +109,337 @@ func ParseCLI(args []string) (*CLIOptions, error) {
 	if err := validateComparisonMode(opts.ComparisonMode); err != nil {
 		return nil, fmt.Errorf("invalid comparison mode: %w", err)
 	}
+	if err := validatePartialGroup(opts.GroupSize, opts.PickCount); err != nil {
+		return nil, fmt.Errorf("invalid group: %w", err)
+	}
+
+	// Validate decision bands
+	if opts.TargetAccepted < 0 || opts.Waitlist < 0 {
+		return nil, fmt.Errorf("invalid decision bands: target-accepted and waitlist must not be negative")
+	}
+
+	// Validate grouping and per-group quotas
+	if opts.Quota != "" && opts.GroupBy == "" {
+		return nil, fmt.Errorf("invalid quotas: --quota requires --group-by")
+	}
+	if _, err := ParseGroupQuotas(opts.Quota); err != nil {
+		return nil, fmt.Errorf("invalid quotas: %w", err)
+	}
+
+	// Validate Elo engine parameters
+	if err := validateEloBounds(opts.KFactor, opts.MinRating, opts.MaxRating); err != nil {
+		return nil, fmt.Errorf("invalid Elo settings: %w", err)
+	}
+
+	// Validate rating system
+	if err := validateRatingSystem(opts.RatingSystem); err != nil {
+		return nil, fmt.Errorf("invalid rating system: %w", err)
+	}
 
 	return &opts, nil
 }
 
+// MergeOptions defines the command-line flags of the merge command
+type MergeOptions struct {
+	Sessions string `long:"sessions" description:"Comma-separated names of the reviewer sessions to merge (at least 2)"`
+	Output   string `long:"output" description:"Name of the consensus session to create"`
+	Top      int    `long:"top" description:"Number of most disputed proposals to report" default:"10"`
+
+	Verbose bool `long:"verbose" short:"v" description:"Enable detailed logging output"`
+}
+
+// SessionNames returns the trimmed, non-empty session names listed in --sessions
+func (opts *MergeOptions) SessionNames() []string {
+	names := make([]string, 0)
+	for _, name := range strings.Split(opts.Sessions, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// ParseMergeCLI parses the arguments of the merge command (without the command name)
+func ParseMergeCLI(args []string) (*MergeOptions, error) {
+	var opts MergeOptions
+
+	parser := flags.NewParser(&opts, flags.Default)
+	parser.Usage = "merge [OPTIONS]"
+
+	remaining, err := parser.ParseArgs(args)
+	if err != nil {
+		if flagsErr, ok := err.(*flags.Error); ok && flagsErr.Type == flags.ErrHelp {
+			return &opts, err
+		}
+		return nil, fmt.Errorf("failed to parse command-line arguments: %w", err)
+	}
+
+	if len(remaining) > 0 {
+		return nil, fmt.Errorf("unexpected arguments: %v", remaining)
+	}
+
+	if len(opts.SessionNames()) < 2 {
+		return nil, fmt.Errorf("at least 2 sessions are required (use --sessions a,b)")
+	}
+
+	if opts.Output == "" {
+		return nil, fmt.Errorf("consensus session name is required (use --output)")
+	}
+	if err := ValidateSessionName(opts.Output); err != nil {
+		return nil, fmt.Errorf("invalid output session: %w", err)
+	}
+
+	return &opts, nil
+}
+
+// ExportOptions defines the command-line flags of the export command
+type ExportOptions struct {
+	SessionName string `long:"session-name" description:"Session whose ranking to export"`
+	Output      string `long:"output" short:"o" description:"File to write the ranking to (never the input CSV)"`
+	Format      string `long:"format" description:"Output format: csv, json, yaml, markdown or html (default: from the output extension, then the session's export format)"`
+}
+
+// ParseExportCLI parses the arguments of the export command (without the command name)
+func ParseExportCLI(args []string) (*ExportOptions, error) {
+	var opts ExportOptions
+
+	parser := flags.NewParser(&opts, flags.Default)
+	parser.Usage = "export [OPTIONS]"
+
+	remaining, err := parser.ParseArgs(args)
+	if err != nil {
+		if flagsErr, ok := err.(*flags.Error); ok && flagsErr.Type == flags.ErrHelp {
+			return &opts, err
+		}
+		return nil, fmt.Errorf("failed to parse command-line arguments: %w", err)
+	}
+
+	if len(remaining) > 0 {
+		return nil, fmt.Errorf("unexpected arguments: %v", remaining)
+	}
+
+	if opts.SessionName == "" {
+		return nil, fmt.Errorf("session name is required (use --session-name)")
+	}
+	if err := ValidateSessionName(opts.SessionName); err != nil {
+		return nil, fmt.Errorf("invalid session name: %w", err)
+	}
+
+	if opts.Output == "" {
+		return nil, fmt.Errorf("output file is required (use --output)")
+	}
+
+	if opts.Format != "" {
+		opts.Format = strings.ToLower(opts.Format)
+		switch opts.Format {
+		case "md":
+			opts.Format = ExportFormatMarkdown
+		case "yml":
+			opts.Format = ExportFormatYAML
+		}
+		switch opts.Format {
+		case ExportFormatCSV, ExportFormatJSON, ExportFormatYAML, ExportFormatMarkdown, ExportFormatHTML:
+		default:
+			return nil, fmt.Errorf("%w: '%s' must be one of: csv, json, yaml, markdown, html", ErrUnsupportedExportFormat, opts.Format)
+		}
+	}
+
+	return &opts, nil
+}
+
+// FormatFor returns the requested export format, inferring it from the output
+// file extension and falling back to the given default
+func (opts *ExportOptions) FormatFor(defaultFormat string) string {
+	if opts.Format != "" {
+		return opts.Format
+	}
+
+	switch strings.ToLower(filepath.Ext(opts.Output)) {
+	case ".csv":
+		return ExportFormatCSV
+	case ".json":
+		return ExportFormatJSON
+	case ".yaml", ".yml":
+		return ExportFormatYAML
+	case ".md", ".markdown":
+		return ExportFormatMarkdown
+	case ".html", ".htm":
+		return ExportFormatHTML
+	}
+	return defaultFormat
+}
+
+// Session management actions of the sessions command
+const (
+	SessionsList     = "list"     // List all sessions
+	SessionsInfo     = "info"     // Show details of one session
+	SessionsDelete   = "delete"   // Delete one session with its history log
+	SessionsValidate = "validate" // Validate one or all session files
+)
+
+// SessionsOptions defines the command-line flags of the sessions command
+type SessionsOptions struct {
+	Action string `no-flag:"true"` // Management action (first positional argument)
+
+	SessionName string `long:"session-name" description:"Session to inspect, delete or validate (validate checks all sessions when omitted)"`
+	JSON        bool   `long:"json" description:"Print machine-readable JSON instead of text"`
+}
+
+// ParseSessionsCLI parses the arguments of the sessions command (without the command name)
+func ParseSessionsCLI(args []string) (*SessionsOptions, error) {
+	var opts SessionsOptions
+
+	parser := flags.NewParser(&opts, flags.Default)
+	parser.Usage = "sessions list|info|delete|validate [OPTIONS]"
+
+	remaining, err := parser.ParseArgs(args)
+	if err != nil {
+		if flagsErr, ok := err.(*flags.Error); ok && flagsErr.Type == flags.ErrHelp {
+			return &opts, err
+		}
+		return nil, fmt.Errorf("failed to parse command-line arguments: %w", err)
+	}
+
+	if len(remaining) == 0 {
+		return nil, fmt.Errorf("action is required: list, info, delete or validate")
+	}
+	if len(remaining) > 1 {
+		return nil, fmt.Errorf("unexpected arguments: %v", remaining[1:])
+	}
+	opts.Action = remaining[0]
+
+	switch opts.Action {
+	case SessionsList:
+	case SessionsInfo, SessionsDelete:
+		if opts.SessionName == "" {
+			return nil, fmt.Errorf("session name is required for %s (use --session-name)", opts.Action)
+		}
+	case SessionsValidate:
+	default:
+		return nil, fmt.Errorf("unknown action '%s': must be one of list, info, delete, validate", opts.Action)
+	}
+
+	if opts.SessionName != "" {
+		if err := ValidateSessionName(opts.SessionName); err != nil {
+			return nil, fmt.Errorf("invalid session name: %w", err)
+		}
+	}
+
+	return &opts, nil
+}
+
+// SimulateOptions defines the command-line flags of the simulate command
+type SimulateOptions struct {
+	Input          string  `long:"input" short:"i" description:"CSV file with the proposals to simulate"`
+	Strategies     string  `long:"strategy" description:"Comma-separated matchup strategies: information-gain, sequential" default:"information-gain,sequential"`
+	ComparisonMode string  `long:"comparison-mode" description:"Comma-separated comparison modes: pairwise, trio, quartet" default:"pairwise,trio,quartet"`
+	KFactors       string  `long:"k-factor" description:"Comma-separated Elo K-factors" default:"16,32,48"`
+	Comparisons    int     `long:"comparisons" description:"Comparisons per simulated session" default:"300"`
+	Step           int     `long:"step" description:"Comparisons between two accuracy measurements" default:"25"`
+	Noise          float64 `long:"noise" description:"Standard deviation of the simulated reviewer's judgement, in rating points" default:"100"`
+	Spread         float64 `long:"spread" description:"Standard deviation of the hidden true strengths, in rating points" default:"200"`
+	TargetAccepted int     `long:"target-accepted" short:"t" description:"Size of the top set whose precision is reported" default:"10"`
+	Seed           int64   `long:"seed" description:"Seed of the hidden strengths and the reviewer's noise" default:"1"`
+	JSON           bool    `long:"json" description:"Print machine-readable JSON instead of text"`
+
+	strategies []string
+	methods    []ComparisonMethod
+	kFactors   []int
+}
+
+// ParseSimulateCLI parses the arguments of the simulate command (without the command name)
+func ParseSimulateCLI(args []string) (*SimulateOptions, error) {
+	var opts SimulateOptions
+
+	parser := flags.NewParser(&opts, flags.Default)
+	parser.Usage = "simulate [OPTIONS]"
+
+	remaining, err := parser.ParseArgs(args)
+	if err != nil {
+		if flagsErr, ok := err.(*flags.Error); ok && flagsErr.Type == flags.ErrHelp {
+			return &opts, err
+		}
+		return nil, fmt.Errorf("failed to parse command-line arguments: %w", err)
+	}
+
+	if len(remaining) > 0 {
+		return nil, fmt.Errorf("unexpected arguments: %v", remaining)
+	}
+
+	if opts.Input == "" {
+		return nil, fmt.Errorf("input file is required (use --input)")
+	}
+
+	for _, name := range splitList(opts.Strategies) {
+		if name != StrategyInformationGain && name != StrategySequential {
+			return nil, fmt.Errorf("invalid strategy '%s': must be %s or %s", name, StrategyInformationGain, StrategySequential)
+		}
+		opts.strategies = append(opts.strategies, name)
+	}
+	for _, mode := range splitList(opts.ComparisonMode) {
+		if err := validateComparisonMode(mode); err != nil {
+			return nil, fmt.Errorf("invalid comparison mode: %w", err)
+		}
+		if ComparisonMethod(mode).IsPartial() {
+			// The simulated reviewer always ranks every presented proposal
+			return nil, fmt.Errorf("invalid comparison mode: %s cannot be simulated", mode)
+		}
+		opts.methods = append(opts.methods, ComparisonMethod(mode))
+	}
+	for _, value := range splitList(opts.KFactors) {
+		kFactor, err := strconv.Atoi(value)
+		if err != nil || kFactor <= 0 {
+			return nil, fmt.Errorf("invalid k-factor '%s': must be a positive integer", value)
+		}
+		opts.kFactors = append(opts.kFactors, kFactor)
+	}
+	if len(opts.strategies) == 0 || len(opts.methods) == 0 || len(opts.kFactors) == 0 {
+		return nil, fmt.Errorf("at least one strategy, comparison mode and k-factor is required")
+	}
+
+	if opts.Comparisons <= 0 || opts.Step <= 0 {
+		return nil, fmt.Errorf("comparisons and step must be positive")
+	}
+	if opts.Noise < 0 || opts.Spread <= 0 {
+		return nil, fmt.Errorf("noise must not be negative and spread must be positive")
+	}
+	if opts.TargetAccepted <= 0 {
+		return nil, fmt.Errorf("target-accepted must be positive")
+	}
+
+	return &opts, nil
+}
+
+// Settings returns the simulation settings selected by the options
+func (opts *SimulateOptions) Settings() SimulationSettings {
+	convergence := DefaultConvergenceConfig()
+	convergence.TargetAccepted = opts.TargetAccepted
+
+	return SimulationSettings{
+		Strategies:  opts.strategies,
+		Methods:     opts.methods,
+		KFactors:    opts.kFactors,
+		Comparisons: opts.Comparisons,
+		Step:        opts.Step,
+		Noise:       opts.Noise,
+		Spread:      opts.Spread,
+		Seed:        opts.Seed,
+		Elo:         DefaultEloConfig(),
+		Convergence: convergence,
+	}
+}
+
+// splitList returns the trimmed, non-empty entries of a comma-separated list
+func splitList(list string) []string {
+	entries := make([]string, 0)
+	for _, entry := range strings.Split(list, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
 // validateOutputScale validates the output scale format
 func validateOutputScale(scale string) error {
 	if scale == "" {
@@ -99,7 +457,7 @@ func validateOutputScale(scale string) error {
 
 // validateComparisonMode validates the comparison mode value
 func validateComparisonMode(mode string) error {
-	validModes := []string{"pairwise", "trio", "quartet"}
+	validModes := []string{"pairwise", "trio", "quartet", "pick-best", "rank-top"}
 
 	for _, valid := range validModes {
 		if mode == valid {
@@ -110,6 +468,32 @@ func validateComparisonMode(mode string) error {
 	return fmt.Errorf("comparison mode must be one of: %s", strings.Join(validModes, ", "))
 }
 
+// validateEloBounds validates the K-factor and rating bounds
+func validateEloBounds(kFactor int, minRating, maxRating float64) error {
+	if kFactor <= 0 {
+		return fmt.Errorf("k-factor must be positive, got %d", kFactor)
+	}
+
+	if minRating >= maxRating {
+		return fmt.Errorf("min-rating (%.1f) must be less than max-rating (%.1f)", minRating, maxRating)
+	}
+
+	return nil
+}
+
+// validateRatingSystem validates the rating system value
+func validateRatingSystem(system string) error {
+	validSystems := []string{RatingSystemElo, RatingSystemGlicko2}
+
+	for _, valid := range validSystems {
+		if system == valid {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("rating system must be one of: %s", strings.Join(validSystems, ", "))
+}
+
 // ShowHelp displays comprehensive usage information for the simplified CLI
 func ShowHelp(programName string) {
 	fmt.Printf("confelo - Conference Talk Ranking System\n\n")
@@ -128,6 +512,28 @@ func ShowHelp(programName string) {
 	fmt.Printf("  # Start with custom settings\n")
 	fmt.Printf("  %s --session-name \"Advanced\" --input talks.csv \\\n", programName)
 	fmt.Printf("    --comparison-mode trio --initial-rating 1600 --target-accepted 15\n\n")
+	fmt.Printf("  # Make ratings more sensitive and narrow the rating range\n")
+	fmt.Printf("  %s --session-name \"Tuned\" --input talks.csv \\\n", programName)
+	fmt.Printf("    --k-factor 48 --min-rating 1000 --max-rating 2000\n\n")
+	fmt.Printf("  # Track rating uncertainty with Glicko-2 instead of classic Elo\n")
+	fmt.Printf("  %s --session-name \"Glicko\" --input talks.csv --rating-system glicko2\n\n", programName)
+	fmt.Printf("  # Record comparisons under a reviewer name in a shared session\n")
+	fmt.Printf("  %s --session-name \"MyConf2025\" --reviewer \"Jane Doe\"\n\n", programName)
+
+	fmt.Printf("  # Exclude proposals you have a conflict of interest with\n")
+	fmt.Printf("  %s --session-name \"MyConf2025\" --conflicts \"acme,speaker:Jane Doe\"\n\n", programName)
+	fmt.Printf("  # Rank per track with a quota of accepted talks for each track\n")
+	fmt.Printf("  %s --session-name \"MyConf2025\" --input talks.csv --group-by track --quota dev=6,ops=4,community=2\n\n", programName)
+	fmt.Printf("  # Merge independent reviewer sessions into a consensus session\n")
+	fmt.Printf("  %s merge --sessions alice,bob,carol --output consensus\n\n", programName)
+	fmt.Printf("  # Export the ranking of a session to a new file\n")
+	fmt.Printf("  %s export --session-name \"MyConf2025\" --output ranked.csv --format csv|json|yaml|markdown|html\n\n", programName)
+	fmt.Printf("  # Manage sessions without opening the interface\n")
+	fmt.Printf("  %s sessions list --json\n", programName)
+	fmt.Printf("  %s sessions info|delete --session-name \"MyConf2025\"\n", programName)
+	fmt.Printf("  %s sessions validate\n\n", programName)
+	fmt.Printf("  # Measure ranking accuracy of strategies and K-factors with a simulated reviewer\n")
+	fmt.Printf("  %s simulate --input talks.csv --comparison-mode pairwise,trio --k-factor 16,32\n\n", programName)
 
 	parser := flags.NewParser(&CLIOptions{}, flags.Default)
 	parser.Usage = "[OPTIONS]"
@@ -184,14 +590,41 @@ func CreateSessionConfigFromCLI(opts *CLIOptions) (*SessionConfig, error) {
 	// Apply CLI overrides
 	config.Elo.InitialRating = opts.InitialRating
 	config.UI.ComparisonMode = opts.ComparisonMode
+	if opts.GroupSize != 0 {
+		config.UI.GroupSize = opts.GroupSize
+	}
+	if opts.PickCount != 0 {
+		config.UI.PickCount = opts.PickCount
+	}
+
+	// Apply Elo engine overrides (zero values keep the defaults)
+	if opts.KFactor != 0 {
+		config.Elo.KFactor = opts.KFactor
+	}
+	config.Elo.MinRating = opts.MinRating
+	if opts.MaxRating != 0 {
+		config.Elo.MaxRating = opts.MaxRating
+	}
+	config.Elo.RatingSystem = opts.RatingSystem
 
 	// Parse output scale to set OutputMin, OutputMax, and UseDecimals
 	if err := applyOutputScale(&config, opts.OutputScale); err != nil {
 		return nil, fmt.Errorf("failed to parse output scale: %w", err)
 	}
 
-	// Set convergence target
+	// Set convergence target and decision bands
 	config.Convergence.TargetAccepted = opts.TargetAccepted
+	config.Convergence.WaitlistSize = opts.Waitlist
+
+	// Group proposals by a CSV column with per-group quotas
+	config.Groups.Column = strings.TrimSpace(opts.GroupBy)
+	quotas, err := ParseGroupQuotas(opts.Quota)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse quotas: %w", err)
+	}
+	if len(quotas) > 0 {
+		config.Groups.Quotas = quotas
+	}
 
 	// Validate final configuration
 	if err := config.Validate(); err != nil {
@@ -7,6 +7,8 @@ import (
 	"github.com/jessevdk/go-flags"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/pashagolub/confelo/pkg/elo"
 )
 
 func TestParseCLI(t *testing.T) {
@@ -62,6 +64,7 @@ func TestParseCLI(t *testing.T) {
 		assert.Equal(t, 1500.0, opts.InitialRating) // Default value
 		assert.Equal(t, "0-100", opts.OutputScale)  // Default value
 		assert.Equal(t, 10, opts.TargetAccepted)    // Default value
+		assert.Equal(t, 5, opts.Waitlist)           // Default value
 		assert.False(t, opts.Verbose)
 		assert.False(t, opts.Version)
 	})
@@ -109,6 +112,21 @@ func TestParseCLI(t *testing.T) {
 		assert.Contains(t, err.Error(), "invalid comparison mode")
 	})
 
+	t.Run("PartialGroup", func(t *testing.T) {
+		opts, err := ParseCLI([]string{"--session-name", "TestSession", "--comparison-mode", "rank-top", "--group-size", "8", "--pick-count", "2"})
+		require.NoError(t, err)
+		config, err := CreateSessionConfigFromCLI(opts)
+		require.NoError(t, err)
+		assert.Equal(t, "rank-top", config.UI.ComparisonMode)
+		assert.Equal(t, 8, config.UI.GroupSize)
+		assert.Equal(t, 2, config.UI.PickCount)
+
+		_, err = ParseCLI([]string{"--session-name", "TestSession", "--group-size", "9"})
+		assert.ErrorContains(t, err, "invalid group")
+		_, err = ParseCLI([]string{"--session-name", "TestSession", "--group-size", "4", "--pick-count", "4"})
+		assert.ErrorContains(t, err, "invalid group")
+	})
+
 	t.Run("InvalidOutputScale", func(t *testing.T) {
 		args := []string{
 			"--session-name", "TestSession",
@@ -133,10 +151,150 @@ func TestParseCLI(t *testing.T) {
 		assert.Equal(t, 1500.0, opts.InitialRating)
 		assert.Equal(t, "0-100", opts.OutputScale)
 		assert.Equal(t, 10, opts.TargetAccepted)
+		assert.Equal(t, 32, opts.KFactor)
+		assert.Equal(t, 0.0, opts.MinRating)
+		assert.Equal(t, 3000.0, opts.MaxRating)
+		assert.Equal(t, RatingSystemElo, opts.RatingSystem)
+		assert.Empty(t, opts.Reviewer)
 		assert.False(t, opts.Verbose)
 		assert.False(t, opts.Version)
 	})
 
+	t.Run("EloSettings", func(t *testing.T) {
+		args := []string{
+			"--session-name", "TestSession",
+			"--k-factor", "48",
+			"--min-rating", "1000",
+			"--max-rating", "2000",
+		}
+
+		opts, err := ParseCLI(args)
+		require.NoError(t, err)
+		assert.Equal(t, 48, opts.KFactor)
+		assert.Equal(t, 1000.0, opts.MinRating)
+		assert.Equal(t, 2000.0, opts.MaxRating)
+	})
+
+	t.Run("Reviewer", func(t *testing.T) {
+		args := []string{
+			"--session-name", "TestSession",
+			"--reviewer", "Jane Doe",
+		}
+
+		opts, err := ParseCLI(args)
+		require.NoError(t, err)
+		assert.Equal(t, "Jane Doe", opts.Reviewer)
+	})
+
+	t.Run("Conflicts", func(t *testing.T) {
+		args := []string{
+			"--session-name", "TestSession",
+			"--conflicts", "acme,speaker:Jane Doe",
+		}
+
+		opts, err := ParseCLI(args)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"acme", "speaker:Jane Doe"}, ParseConflictTags(opts.Conflicts))
+	})
+
+	t.Run("Triage", func(t *testing.T) {
+		opts, err := ParseCLI([]string{"--session-name", "TestSession", "--triage"})
+		require.NoError(t, err)
+		assert.True(t, opts.Triage)
+	})
+
+	t.Run("IsSet", func(t *testing.T) {
+		opts, err := ParseCLI([]string{"--session-name", "TestSession", "--waitlist", "5"})
+		require.NoError(t, err)
+		assert.True(t, opts.IsSet("waitlist"))
+		assert.True(t, opts.IsSet("session-name"))
+		assert.False(t, opts.IsSet("target-accepted"))
+		assert.Equal(t, 10, opts.TargetAccepted)
+	})
+
+	t.Run("RatingSystem", func(t *testing.T) {
+		args := []string{
+			"--session-name", "TestSession",
+			"--rating-system", "glicko2",
+		}
+
+		opts, err := ParseCLI(args)
+		require.NoError(t, err)
+		assert.Equal(t, RatingSystemGlicko2, opts.RatingSystem)
+	})
+
+	t.Run("InvalidRatingSystem", func(t *testing.T) {
+		args := []string{
+			"--session-name", "TestSession",
+			"--rating-system", "trueskill",
+		}
+
+		_, err := ParseCLI(args)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "rating system must be one of")
+	})
+
+	t.Run("InvalidKFactor", func(t *testing.T) {
+		args := []string{
+			"--session-name", "TestSession",
+			"--k-factor", "0",
+		}
+
+		_, err := ParseCLI(args)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "k-factor must be positive")
+	})
+
+	t.Run("NegativeWaitlist", func(t *testing.T) {
+		args := []string{
+			"--session-name", "TestSession",
+			"--waitlist", "-1",
+		}
+
+		_, err := ParseCLI(args)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid decision bands")
+	})
+
+	t.Run("GroupQuotas", func(t *testing.T) {
+		args := []string{
+			"--session-name", "TestSession",
+			"--group-by", "track",
+			"--quota", "dev=6,ops=4,community=2",
+		}
+
+		opts, err := ParseCLI(args)
+		require.NoError(t, err)
+		assert.Equal(t, "track", opts.GroupBy)
+
+		config, err := CreateSessionConfigFromCLI(opts)
+		require.NoError(t, err)
+		assert.Equal(t, "track", config.Groups.Column)
+		assert.Equal(t, map[string]int{"dev": 6, "ops": 4, "community": 2}, config.Groups.Quotas)
+	})
+
+	t.Run("InvalidGroupQuotas", func(t *testing.T) {
+		_, err := ParseCLI([]string{"--session-name", "TestSession", "--quota", "dev=6"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--quota requires --group-by")
+
+		_, err = ParseCLI([]string{"--session-name", "TestSession", "--group-by", "track", "--quota", "dev"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid quotas")
+	})
+
+	t.Run("InvalidRatingBounds", func(t *testing.T) {
+		args := []string{
+			"--session-name", "TestSession",
+			"--min-rating", "2000",
+			"--max-rating", "1000",
+		}
+
+		_, err := ParseCLI(args)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "min-rating")
+	})
+
 	t.Run("UnexpectedArguments", func(t *testing.T) {
 		args := []string{
 			"--session-name", "TestSession",
@@ -242,6 +400,7 @@ func TestCreateSessionConfigFromCLI(t *testing.T) {
 			InitialRating:  1600.0,
 			OutputScale:    "1-10",
 			TargetAccepted: 20,
+			Waitlist:       8,
 		}
 
 		config, err := CreateSessionConfigFromCLI(opts)
@@ -251,6 +410,247 @@ func TestCreateSessionConfigFromCLI(t *testing.T) {
 		assert.Equal(t, "trio", config.UI.ComparisonMode)
 		assert.Equal(t, 1600.0, config.Elo.InitialRating)
 		assert.Equal(t, 20, config.Convergence.TargetAccepted)
+		assert.Equal(t, 8, config.Convergence.WaitlistSize)
+	})
+
+	t.Run("EloConfiguration", func(t *testing.T) {
+		opts := &CLIOptions{
+			SessionName:    "EloSession",
+			ComparisonMode: "pairwise",
+			InitialRating:  1500.0,
+			KFactor:        16,
+			MinRating:      1000.0,
+			MaxRating:      2000.0,
+			OutputScale:    "0-100",
+			TargetAccepted: 10,
+		}
+
+		config, err := CreateSessionConfigFromCLI(opts)
+		require.NoError(t, err)
+
+		assert.Equal(t, 16, config.Elo.KFactor)
+		assert.Equal(t, 1000.0, config.Elo.MinRating)
+		assert.Equal(t, 2000.0, config.Elo.MaxRating)
+	})
+
+	t.Run("EloConfigurationDefaults", func(t *testing.T) {
+		opts := &CLIOptions{
+			SessionName:    "DefaultEloSession",
+			ComparisonMode: "pairwise",
+			InitialRating:  1500.0,
+			OutputScale:    "0-100",
+		}
+
+		config, err := CreateSessionConfigFromCLI(opts)
+		require.NoError(t, err)
+
+		assert.Equal(t, DefaultEloConfig().KFactor, config.Elo.KFactor)
+		assert.Equal(t, DefaultEloConfig().MaxRating, config.Elo.MaxRating)
+		assert.Empty(t, config.Elo.RatingSystem)
+	})
+
+	t.Run("RatingSystem", func(t *testing.T) {
+		opts := &CLIOptions{
+			SessionName:    "GlickoSession",
+			ComparisonMode: "pairwise",
+			InitialRating:  1500.0,
+			OutputScale:    "0-100",
+			RatingSystem:   RatingSystemGlicko2,
+		}
+
+		config, err := CreateSessionConfigFromCLI(opts)
+		require.NoError(t, err)
+		assert.Equal(t, RatingSystemGlicko2, config.Elo.RatingSystem)
+
+		opts.RatingSystem = "trueskill"
+		_, err = CreateSessionConfigFromCLI(opts)
+		assert.ErrorIs(t, err, ErrInvalidEloConfig)
+	})
+
+	t.Run("InitialRatingOutsideBounds", func(t *testing.T) {
+		opts := &CLIOptions{
+			SessionName:    "OutOfBounds",
+			ComparisonMode: "pairwise",
+			InitialRating:  1500.0,
+			MinRating:      1600.0,
+			MaxRating:      2000.0,
+			OutputScale:    "0-100",
+		}
+
+		_, err := CreateSessionConfigFromCLI(opts)
+		assert.ErrorIs(t, err, ErrInvalidEloConfig)
+	})
+}
+
+func TestParseMergeCLI(t *testing.T) {
+	t.Run("ValidArguments", func(t *testing.T) {
+		opts, err := ParseMergeCLI([]string{"--sessions", "alice, bob,carol", "--output", "consensus"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"alice", "bob", "carol"}, opts.SessionNames())
+		assert.Equal(t, "consensus", opts.Output)
+		assert.Equal(t, 10, opts.Top)
+	})
+
+	t.Run("TooFewSessions", func(t *testing.T) {
+		_, err := ParseMergeCLI([]string{"--sessions", "alice,", "--output", "consensus"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "at least 2 sessions")
+	})
+
+	t.Run("MissingOutput", func(t *testing.T) {
+		_, err := ParseMergeCLI([]string{"--sessions", "alice,bob"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--output")
+	})
+
+	t.Run("InvalidOutput", func(t *testing.T) {
+		_, err := ParseMergeCLI([]string{"--sessions", "alice,bob", "--output", "a/b"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid output session")
+	})
+
+	t.Run("UnexpectedArguments", func(t *testing.T) {
+		_, err := ParseMergeCLI([]string{"--sessions", "alice,bob", "--output", "consensus", "extra"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unexpected arguments")
+	})
+}
+
+func TestParseExportCLI(t *testing.T) {
+	t.Run("ValidArguments", func(t *testing.T) {
+		opts, err := ParseExportCLI([]string{"--session-name", "MyConf2025", "--output", "ranked.txt", "--format", "MD"})
+		require.NoError(t, err)
+		assert.Equal(t, "MyConf2025", opts.SessionName)
+		assert.Equal(t, ExportFormatMarkdown, opts.FormatFor(ExportFormatCSV))
+	})
+
+	t.Run("FormatFromExtension", func(t *testing.T) {
+		opts, err := ParseExportCLI([]string{"--session-name", "MyConf2025", "-o", "ranked.HTML"})
+		require.NoError(t, err)
+		assert.Equal(t, ExportFormatHTML, opts.FormatFor(ExportFormatCSV))
+
+		opts.Output = "ranked.yml"
+		assert.Equal(t, ExportFormatYAML, opts.FormatFor(ExportFormatCSV))
+
+		opts.Output = "ranked.txt"
+		assert.Equal(t, ExportFormatJSON, opts.FormatFor(ExportFormatJSON))
+	})
+
+	t.Run("MissingSession", func(t *testing.T) {
+		_, err := ParseExportCLI([]string{"--output", "ranked.csv"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--session-name")
+	})
+
+	t.Run("MissingOutput", func(t *testing.T) {
+		_, err := ParseExportCLI([]string{"--session-name", "MyConf2025"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--output")
+	})
+
+	t.Run("UnsupportedFormat", func(t *testing.T) {
+		_, err := ParseExportCLI([]string{"--session-name", "MyConf2025", "--output", "ranked.pdf", "--format", "pdf"})
+		assert.ErrorIs(t, err, ErrUnsupportedExportFormat)
+	})
+}
+
+func TestParseSessionsCLI(t *testing.T) {
+	t.Run("List", func(t *testing.T) {
+		opts, err := ParseSessionsCLI([]string{"list", "--json"})
+		require.NoError(t, err)
+		assert.Equal(t, SessionsList, opts.Action)
+		assert.True(t, opts.JSON)
+	})
+
+	t.Run("InfoWithName", func(t *testing.T) {
+		opts, err := ParseSessionsCLI([]string{"info", "--session-name", "MyConf2025"})
+		require.NoError(t, err)
+		assert.Equal(t, SessionsInfo, opts.Action)
+		assert.Equal(t, "MyConf2025", opts.SessionName)
+		assert.False(t, opts.JSON)
+	})
+
+	t.Run("ValidateAll", func(t *testing.T) {
+		opts, err := ParseSessionsCLI([]string{"validate"})
+		require.NoError(t, err)
+		assert.Equal(t, SessionsValidate, opts.Action)
+		assert.Empty(t, opts.SessionName)
+	})
+
+	t.Run("MissingAction", func(t *testing.T) {
+		_, err := ParseSessionsCLI([]string{"--json"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "action is required")
+	})
+
+	t.Run("UnknownAction", func(t *testing.T) {
+		_, err := ParseSessionsCLI([]string{"rename"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unknown action")
+	})
+
+	t.Run("DeleteRequiresName", func(t *testing.T) {
+		_, err := ParseSessionsCLI([]string{"delete"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--session-name")
+	})
+
+	t.Run("InvalidName", func(t *testing.T) {
+		_, err := ParseSessionsCLI([]string{"info", "--session-name", "a/b"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid session name")
+	})
+
+	t.Run("UnexpectedArguments", func(t *testing.T) {
+		_, err := ParseSessionsCLI([]string{"list", "extra"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unexpected arguments")
+	})
+}
+
+func TestParseSimulateCLI(t *testing.T) {
+	t.Run("Defaults", func(t *testing.T) {
+		opts, err := ParseSimulateCLI([]string{"--input", "talks.csv"})
+		require.NoError(t, err)
+
+		settings := opts.Settings()
+		assert.Equal(t, []string{StrategyInformationGain, StrategySequential}, settings.Strategies)
+		assert.Equal(t, []ComparisonMethod{MethodPairwise, MethodTrio, MethodQuartet}, settings.Methods)
+		assert.Equal(t, []int{16, 32, 48}, settings.KFactors)
+		assert.Equal(t, 300, settings.Comparisons)
+		assert.Equal(t, 10, settings.Convergence.TargetAccepted)
+		assert.Equal(t, DefaultEloConfig(), settings.Elo)
+	})
+
+	t.Run("Lists", func(t *testing.T) {
+		opts, err := ParseSimulateCLI([]string{"-i", "talks.csv", "--strategy", " sequential ",
+			"--comparison-mode", "trio,quartet", "--k-factor", "24", "-t", "5", "--json"})
+		require.NoError(t, err)
+
+		settings := opts.Settings()
+		assert.Equal(t, []string{StrategySequential}, settings.Strategies)
+		assert.Equal(t, []ComparisonMethod{MethodTrio, MethodQuartet}, settings.Methods)
+		assert.Equal(t, []int{24}, settings.KFactors)
+		assert.Equal(t, 5, settings.Convergence.TargetAccepted)
+		assert.True(t, opts.JSON)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		for name, args := range map[string][]string{
+			"missing input":  {},
+			"strategy":       {"-i", "talks.csv", "--strategy", "random"},
+			"mode":           {"-i", "talks.csv", "--comparison-mode", "quintet"},
+			"partial mode":   {"-i", "talks.csv", "--comparison-mode", "pairwise,pick-best"},
+			"k-factor":       {"-i", "talks.csv", "--k-factor", "16,0"},
+			"empty list":     {"-i", "talks.csv", "--k-factor", ","},
+			"step":           {"-i", "talks.csv", "--step", "0"},
+			"spread":         {"-i", "talks.csv", "--spread", "0"},
+			"target":         {"-i", "talks.csv", "-t", "0"},
+			"positional arg": {"-i", "talks.csv", "extra"},
+		} {
+			_, err := ParseSimulateCLI(args)
+			assert.Error(t, err, name)
+		}
 	})
 }
 
@@ -607,3 +1007,17 @@ func TestEloConfig_ConvertCSVScoreToElo(t *testing.T) {
 		assert.Equal(t, 1500.0, config.ConvertCSVScoreToElo(50.0))
 	})
 }
+
+func TestEloConfig_EngineConfig(t *testing.T) {
+	config := EloConfig{
+		InitialRating: 1400,
+		KFactor:       24,
+		MinRating:     100,
+		MaxRating:     2900,
+		OutputMin:     1,
+		OutputMax:     5,
+		RatingSystem:  RatingSystemGlicko2,
+	}
+
+	assert.Equal(t, elo.Config{InitialRating: 1400, KFactor: 24, MinRating: 100, MaxRating: 2900}, config.EngineConfig())
+}
@@ -0,0 +1,85 @@
+// Package data provides reviewer conflict-of-interest handling.
+// A reviewer declares conflict tags (employer, speaker names, ...) and every proposal
+// whose ConflictTags intersect them is kept out of that reviewer's comparisons.
+package data
+
+import (
+	"strings"
+)
+
+// ParseConflictTags splits a comma-separated conflict list into trimmed, non-empty tags
+func ParseConflictTags(list string) []string {
+	tags := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, tag := range strings.Split(list, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		tags = append(tags, tag)
+	}
+	return tags
+}
+
+// SetConflicts sets the reviewer's conflict tags used to exclude proposals from comparisons
+func (s *Session) SetConflicts(tags []string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.conflicts = make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			s.conflicts = append(s.conflicts, tag)
+		}
+	}
+}
+
+// GetConflicts returns the reviewer's conflict tags
+func (s *Session) GetConflicts() []string {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	conflicts := make([]string, len(s.conflicts))
+	copy(conflicts, s.conflicts)
+	return conflicts
+}
+
+// GetReviewableProposals returns the proposals whose conflict tags do not intersect the
+// reviewer's conflicts, in session order (thread-safe copy). Proposals triaged as weak
+// are left out too while the session excludes them.
+func (s *Session) GetReviewableProposals() []Proposal {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	collection := &ProposalCollection{Proposals: make([]Proposal, 0, len(s.Proposals))}
+	for _, proposal := range s.Proposals {
+		if !s.ExcludeWeak || s.Triage[proposal.ID] != BucketWeak {
+			collection.Proposals = append(collection.Proposals, proposal)
+		}
+	}
+
+	for _, tag := range s.conflicts {
+		collection.Proposals = collection.ExcludeByConflictTag(tag)
+	}
+
+	return collection.Proposals
+}
+
+// IsConflicted reports whether a proposal is excluded from comparisons by the reviewer's conflicts
+func (s *Session) IsConflicted(proposalID string) bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	idx, exists := s.ProposalIndex[proposalID]
+	if !exists {
+		return false
+	}
+
+	for _, tag := range s.conflicts {
+		if s.Proposals[idx].HasConflictTag(tag) {
+			return true
+		}
+	}
+	return false
+}
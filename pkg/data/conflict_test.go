@@ -0,0 +1,65 @@
+package data
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseConflictTags(t *testing.T) {
+	assert.Equal(t, []string{"acme", "speaker:Jane Doe"}, ParseConflictTags(" acme, speaker:Jane Doe ,,acme"))
+	assert.Empty(t, ParseConflictTags(""))
+}
+
+func TestSessionConflicts(t *testing.T) {
+	newConflictSession := func(t *testing.T) *Session {
+		proposals := createTestProposals()
+		proposals[0].ConflictTags = []string{"acme"}
+		proposals[1].ConflictTags = []string{"globex", "speaker:Jane Doe"}
+
+		session, err := NewSession("Conflict Session", proposals, createTestConfig(), "test.csv")
+		require.NoError(t, err)
+		return session
+	}
+
+	reviewableIDs := func(session *Session) []string {
+		ids := make([]string, 0)
+		for _, proposal := range session.GetReviewableProposals() {
+			ids = append(ids, proposal.ID)
+		}
+		return ids
+	}
+
+	t.Run("no conflicts keeps all proposals", func(t *testing.T) {
+		session := newConflictSession(t)
+
+		assert.Equal(t, []string{"prop1", "prop2", "prop3"}, reviewableIDs(session))
+		assert.False(t, session.IsConflicted("prop1"))
+	})
+
+	t.Run("excludes proposals with intersecting tags", func(t *testing.T) {
+		session := newConflictSession(t)
+		session.SetConflicts([]string{"speaker:Jane Doe", " ", "initech"})
+
+		assert.Equal(t, []string{"speaker:Jane Doe", "initech"}, session.GetConflicts())
+		assert.Equal(t, []string{"prop1", "prop3"}, reviewableIDs(session))
+		assert.True(t, session.IsConflicted("prop2"))
+		assert.False(t, session.IsConflicted("prop1"))
+		assert.False(t, session.IsConflicted("missing"))
+
+		session.SetConflicts([]string{"acme", "globex"})
+		assert.Equal(t, []string{"prop3"}, reviewableIDs(session))
+	})
+
+	t.Run("reviewable proposals are a copy", func(t *testing.T) {
+		session := newConflictSession(t)
+
+		proposals := session.GetReviewableProposals()
+		proposals[0].Score = 2000
+
+		proposal, err := session.GetProposalByID(proposals[0].ID)
+		require.NoError(t, err)
+		assert.Equal(t, 1500.0, proposal.Score)
+	})
+}
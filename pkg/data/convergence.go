@@ -0,0 +1,104 @@
+// Package data provides convergence tracking for ranking sessions.
+// Convergence is decided by elo.Engine.CheckConvergence alone; the session maps its
+// ConvergenceConfig onto the engine's stopping criteria and persists the outcome in
+// ConvergenceMetrics so every screen shows the same recommendation.
+package data
+
+import (
+	"github.com/pashagolub/confelo/pkg/elo"
+)
+
+// OptimizationConfig maps the convergence settings onto the engine's stopping criteria:
+// the stability threshold and window, the top-T size, the comparison limits and the
+// confidence needed to recommend stopping
+func (c ConvergenceConfig) OptimizationConfig() elo.OptimizationConfig {
+	config := elo.DefaultOptimizationConfig()
+	if c.StabilityThreshold > 0 {
+		config.StabilityThreshold = c.StabilityThreshold
+	}
+	if c.TopTStabilityWindow > 0 {
+		config.StabilityWindow = c.TopTStabilityWindow
+	}
+	if c.TargetAccepted > 0 {
+		config.TopNForStability = c.TargetAccepted
+	}
+	if c.ConfidenceThreshold > 0 {
+		config.ConfidenceThreshold = c.ConfidenceThreshold
+	}
+	config.MinComparisons = max(c.MinComparisons, 0)
+	config.MaxComparisons = max(c.MaxComparisons, 0)
+	return config
+}
+
+// ComparisonHistoryOf converts completed comparisons into engine history, dropping skipped ones
+func ComparisonHistoryOf(comparisons []Comparison) *elo.ComparisonHistory {
+	history := elo.NewComparisonHistory()
+
+	for _, comparison := range comparisons {
+		if comparison.Skipped {
+			continue
+		}
+
+		result := elo.ComparisonResult{
+			Updates:   make([]elo.RatingUpdate, 0, len(comparison.EloUpdates)),
+			Method:    comparison.Method.EloMethod(),
+			Timestamp: comparison.Timestamp,
+			Duration:  comparison.Duration,
+		}
+		for _, update := range comparison.EloUpdates {
+			result.Updates = append(result.Updates, elo.RatingUpdate{
+				ProposalID: update.ProposalID,
+				OldRating:  update.OldRating,
+				NewRating:  update.NewRating,
+				Delta:      update.RatingDelta,
+				KFactor:    update.KFactor,
+			})
+			history.RatingHistory[update.ProposalID] = append(history.RatingHistory[update.ProposalID], update.NewRating)
+		}
+
+		history.Comparisons = append(history.Comparisons, result)
+		history.RecordPairs(comparison.ProposalIDs...)
+	}
+
+	return history
+}
+
+// EloMethod maps a comparison method to the engine method
+func (m ComparisonMethod) EloMethod() elo.ComparisonMethod {
+	switch m {
+	case MethodTrio:
+		return elo.Trio
+	case MethodQuartet:
+		return elo.Quartet
+	case MethodPickBest, MethodRankTop:
+		return elo.Group
+	default:
+		return elo.Pairwise
+	}
+}
+
+// checkConvergence runs the engine's stopping criteria against the session (caller holds the lock)
+func (s *Session) checkConvergence() *elo.ConvergenceStatus {
+	engine, err := elo.NewEngine(s.Config.Elo.EngineConfig())
+	if err != nil {
+		// The stopping criteria do not depend on the rating parameters
+		defaults := DefaultEloConfig()
+		engine, err = elo.NewEngine(defaults.EngineConfig())
+		if err != nil {
+			return nil
+		}
+	}
+
+	ratings := make([]elo.Rating, len(s.Proposals))
+	for i, proposal := range s.Proposals {
+		ratings[i] = elo.Rating{
+			ID:         proposal.ID,
+			Score:      proposal.Score,
+			Deviation:  proposal.Deviation,
+			Volatility: proposal.Volatility,
+			Games:      s.ComparisonCounts[proposal.ID],
+		}
+	}
+
+	return engine.CheckConvergence(ratings, ComparisonHistoryOf(s.CompletedComparisons), s.Config.Convergence.OptimizationConfig())
+}
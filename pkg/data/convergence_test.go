@@ -0,0 +1,196 @@
+package data
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pashagolub/confelo/pkg/elo"
+)
+
+// loadConvergenceSession creates a session from a CSV in the repository's testdata directory
+func loadConvergenceSession(t *testing.T, file string, convergence ConvergenceConfig) *Session {
+	config := DefaultSessionConfig()
+	config.Convergence = convergence
+
+	csvPath := filepath.Join("..", "..", "testdata", file)
+	result, err := NewFileStorage().LoadProposalsFromCSVWithElo(csvPath, config.CSV, &config.Elo)
+	require.NoError(t, err)
+
+	session, err := NewSession("convergence", result.Proposals, config, csvPath)
+	require.NoError(t, err)
+	return session
+}
+
+// simulateUntilStop records pairwise comparisons in a fixed round-robin order, where the
+// proposal listed first in the CSV always wins, until the session recommends stopping.
+// It returns the number of comparisons made, or -1 when limit is reached first.
+func simulateUntilStop(t *testing.T, session *Session, limit int) int {
+	eloConfig := session.Config.Elo
+	engine, err := elo.NewEngine(elo.Config{
+		InitialRating: eloConfig.InitialRating,
+		KFactor:       eloConfig.KFactor,
+		MinRating:     eloConfig.MinRating,
+		MaxRating:     eloConfig.MaxRating,
+	})
+	require.NoError(t, err)
+
+	proposals := session.GetProposals()
+	index := make(map[string]int, len(proposals))
+	for i, proposal := range proposals {
+		index[proposal.ID] = i
+	}
+
+	made := 0
+	for offset := 1; made < limit; offset = offset%(len(proposals)-1) + 1 {
+		for i := 0; i < len(proposals) && made < limit; i++ {
+			if session.GetConvergenceMetrics().ShouldStop {
+				return made
+			}
+
+			a, b := proposals[i].ID, proposals[(i+offset)%len(proposals)].ID
+			if index[b] < index[a] {
+				a, b = b, a
+			}
+			winner, err := session.GetProposalByID(a)
+			require.NoError(t, err)
+			loser, err := session.GetProposalByID(b)
+			require.NoError(t, err)
+
+			newWinner, newLoser, err := engine.CalculatePairwise(
+				elo.Rating{ID: a, Score: winner.Score}, elo.Rating{ID: b, Score: loser.Score})
+			require.NoError(t, err)
+
+			id := fmt.Sprintf("sim_%d", made)
+			require.NoError(t, session.RecordComparison(Comparison{
+				ID:          id,
+				ProposalIDs: []string{a, b},
+				WinnerID:    a,
+				Method:      MethodPairwise,
+				EloUpdates: []EloUpdate{
+					{ComparisonID: id, ProposalID: a, OldRating: winner.Score, NewRating: newWinner.Score, RatingDelta: newWinner.Score - winner.Score},
+					{ComparisonID: id, ProposalID: b, OldRating: loser.Score, NewRating: newLoser.Score, RatingDelta: newLoser.Score - loser.Score},
+				},
+			}))
+			made++
+		}
+	}
+
+	if session.GetConvergenceMetrics().ShouldStop {
+		return made
+	}
+	return -1
+}
+
+func TestConvergenceConfig_OptimizationConfig(t *testing.T) {
+	config := DefaultConvergenceConfig().OptimizationConfig()
+
+	assert.Equal(t, 10, config.TopNForStability)
+	assert.Equal(t, 5, config.StabilityWindow)
+	assert.Equal(t, 5.0, config.StabilityThreshold)
+	assert.Equal(t, 20, config.MinComparisons)
+	assert.Equal(t, 1000, config.MaxComparisons)
+	assert.Equal(t, 0.8, config.ConfidenceThreshold)
+
+	// Unset values keep the engine defaults
+	config = ConvergenceConfig{}.OptimizationConfig()
+	assert.Equal(t, elo.DefaultOptimizationConfig(), config)
+}
+
+func TestSession_ConvergenceMetricsFromEngine(t *testing.T) {
+	session := loadConvergenceSession(t, "proposals-small.csv", DefaultConvergenceConfig())
+
+	metrics := session.GetConvergenceMetrics()
+	require.NotNil(t, metrics)
+	assert.False(t, metrics.ShouldStop)
+
+	simulateUntilStop(t, session, 5)
+
+	metrics = session.GetConvergenceMetrics()
+	assert.Equal(t, 5, metrics.TotalComparisons)
+	assert.False(t, metrics.ShouldStop, "stopping is never recommended before MinComparisons")
+	assert.Greater(t, metrics.RemainingEstimate, 0)
+	assert.ElementsMatch(t, []string{"rating_stability", "ranking_stability", "variance_threshold", "minimum_coverage"},
+		keys(metrics.CriteriaMet))
+	assert.InDelta(t, 20.0, metrics.CoveragePercentage, 0.001) // 10 appearances of 50 needed
+
+	met := 0
+	for _, ok := range metrics.CriteriaMet {
+		if ok {
+			met++
+		}
+	}
+	assert.InDelta(t, float64(met)/4, metrics.ConvergenceScore, 0.001)
+}
+
+func TestSession_ConvergenceStopping(t *testing.T) {
+	t.Run("proposals-small stops once criteria settle", func(t *testing.T) {
+		session := loadConvergenceSession(t, "proposals-small.csv", DefaultConvergenceConfig())
+
+		stoppedAt := simulateUntilStop(t, session, 500)
+		assert.Equal(t, 144, stoppedAt)
+
+		metrics := session.GetConvergenceMetrics()
+		assert.True(t, metrics.ShouldStop)
+		assert.Zero(t, metrics.RemainingEstimate)
+		assert.GreaterOrEqual(t, metrics.ConvergenceScore, 0.8)
+		assert.True(t, metrics.CriteriaMet["minimum_coverage"])
+	})
+
+	t.Run("pgconfeu2025 runs into the comparison limit", func(t *testing.T) {
+		// Without CSV scores all ratings start equal and neighbours keep trading points
+		session := loadConvergenceSession(t, "pgconfeu2025.csv", DefaultConvergenceConfig())
+
+		stoppedAt := simulateUntilStop(t, session, 2000)
+		assert.Equal(t, 1000, stoppedAt)
+
+		metrics := session.GetConvergenceMetrics()
+		assert.True(t, metrics.ShouldStop)
+		assert.False(t, metrics.CriteriaMet["rating_stability"])
+		assert.Less(t, metrics.ConvergenceScore, 0.8)
+	})
+
+	t.Run("minimum comparisons delay stopping", func(t *testing.T) {
+		convergence := DefaultConvergenceConfig()
+		convergence.MinComparisons = 300
+		session := loadConvergenceSession(t, "proposals-small.csv", convergence)
+
+		// Without the minimum this set stops at 144; past 300 the criteria first hold at 315
+		assert.Equal(t, 315, simulateUntilStop(t, session, 500))
+	})
+
+	t.Run("maximum comparisons always stop", func(t *testing.T) {
+		convergence := DefaultConvergenceConfig()
+		convergence.MaxComparisons = 30
+		session := loadConvergenceSession(t, "pgconfeu2025.csv", convergence)
+
+		assert.Equal(t, 30, simulateUntilStop(t, session, 500))
+		assert.Less(t, session.GetConvergenceMetrics().ConvergenceScore, 0.8)
+	})
+
+	t.Run("evaluation survives reload", func(t *testing.T) {
+		session := loadConvergenceSession(t, "proposals-small.csv", DefaultConvergenceConfig())
+		require.Equal(t, 144, simulateUntilStop(t, session, 500))
+
+		sessionFile := filepath.Join(t.TempDir(), "convergence.json")
+		storage := NewFileStorage()
+		require.NoError(t, storage.SaveSession(session, sessionFile))
+		loaded, err := storage.LoadSession(sessionFile)
+		require.NoError(t, err)
+
+		assert.True(t, loaded.GetConvergenceMetrics().ShouldStop)
+		assert.Equal(t, session.GetConvergenceMetrics().CriteriaMet, loaded.GetConvergenceMetrics().CriteriaMet)
+	})
+}
+
+// keys returns the keys of a criteria map
+func keys(criteria map[string]bool) []string {
+	result := make([]string, 0, len(criteria))
+	for criterion := range criteria {
+		result = append(result, criterion)
+	}
+	return result
+}
@@ -0,0 +1,144 @@
+// Package data provides accept/waitlist/reject decisions derived from the ranking.
+// The top TargetAccepted proposals are accepted, the next WaitlistSize proposals are
+// waitlisted and the rest rejected. Proposals whose rating interval spans the
+// acceptance cutoff are flagged as borderline so the committee can review them.
+package data
+
+import (
+	"time"
+
+	"github.com/pashagolub/confelo/pkg/elo"
+)
+
+// Decision is the committee outcome for a proposal
+type Decision string
+
+const (
+	// DecisionAccepted marks proposals within the top TargetAccepted
+	DecisionAccepted Decision = "accepted"
+	// DecisionWaitlist marks proposals in the waitlist band right after the accepted ones
+	DecisionWaitlist Decision = "waitlist"
+	// DecisionRejected marks all remaining proposals
+	DecisionRejected Decision = "rejected"
+)
+
+// decisionIntervalZ is the half-width of a rating interval in standard errors (95% interval)
+const decisionIntervalZ = 1.96
+
+// ProposalDecision is the decision for a single proposal
+type ProposalDecision struct {
+	Decision   Decision // Decision band the proposal's rank falls into
+	Rank       int      // Rank among the proposals decided together (1 = best)
+	Borderline bool     // Whether the rating interval overlaps the acceptance cutoff
+	Low        float64  // Lower bound of the rating interval
+	High       float64  // Upper bound of the rating interval
+}
+
+// SetDecisionBands changes how many proposals the session accepts and waitlists
+func (s *Session) SetDecisionBands(targetAccepted, waitlistSize int) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.Config.Convergence.TargetAccepted = targetAccepted
+	s.Config.Convergence.WaitlistSize = waitlistSize
+	s.UpdatedAt = time.Now()
+}
+
+// GetDecisionBands returns how many proposals the session accepts and waitlists
+func (s *Session) GetDecisionBands() (targetAccepted, waitlistSize int) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.Config.Convergence.TargetAccepted, s.Config.Convergence.WaitlistSize
+}
+
+// DecideProposals assigns decision bands by rank (highest score first) and flags borderline
+// proposals. Uncertainties hold the standard error of each rating; missing entries count
+// as exact ratings, which are still borderline when they tie with the cutoff.
+func DecideProposals(proposals []Proposal, uncertainties map[string]float64, config ConvergenceConfig) map[string]ProposalDecision {
+	ranked := make([]Proposal, len(proposals))
+	copy(ranked, proposals)
+	rankByScore(ranked)
+
+	accepted := min(max(config.TargetAccepted, 0), len(ranked))
+	waitlisted := min(accepted+max(config.WaitlistSize, 0), len(ranked))
+
+	// The cutoff lies halfway between the last accepted and the first other rating
+	hasCutoff := accepted > 0 && accepted < len(ranked)
+	cutoff := 0.0
+	if hasCutoff {
+		cutoff = (ranked[accepted-1].Score + ranked[accepted].Score) / 2
+	}
+
+	decisions := make(map[string]ProposalDecision, len(ranked))
+	for i, proposal := range ranked {
+		margin := decisionIntervalZ * uncertainties[proposal.ID]
+		decision := ProposalDecision{
+			Decision: DecisionRejected,
+			Rank:     i + 1,
+			Low:      proposal.Score - margin,
+			High:     proposal.Score + margin,
+		}
+		switch {
+		case i < accepted:
+			decision.Decision = DecisionAccepted
+		case i < waitlisted:
+			decision.Decision = DecisionWaitlist
+		}
+		decision.Borderline = hasCutoff && decision.Low <= cutoff && cutoff <= decision.High
+		decisions[proposal.ID] = decision
+	}
+
+	return decisions
+}
+
+// RatingUncertainties returns the standard error of each proposal's rating: the rating
+// deviation where one is tracked (Glicko-2), otherwise the standard error of a
+// Bradley–Terry fit of the comparisons, since Elo itself has no notion of uncertainty
+func RatingUncertainties(proposals []Proposal, comparisons []Comparison, initialRating float64) map[string]float64 {
+	uncertainties := make(map[string]float64, len(proposals))
+	needsFit := false
+	for _, proposal := range proposals {
+		if proposal.Deviation > 0 {
+			uncertainties[proposal.ID] = proposal.Deviation
+		} else {
+			needsFit = true
+		}
+	}
+	if !needsFit {
+		return uncertainties
+	}
+
+	ids := make([]string, len(proposals))
+	for i, proposal := range proposals {
+		ids[i] = proposal.ID
+	}
+
+	config := elo.DefaultFitConfig()
+	config.InitialRating = initialRating
+	fit, err := elo.FitPlackettLuce(ids, ComparisonOutcomes(comparisons), config)
+	if err != nil {
+		return uncertainties // Without a fit the remaining ratings count as exact
+	}
+
+	for _, rating := range fit.Ratings {
+		if _, tracked := uncertainties[rating.ID]; !tracked {
+			uncertainties[rating.ID] = rating.StdError
+		}
+	}
+	return uncertainties
+}
+
+// ComparisonOutcomes converts comparisons into rating engine outcomes, dropping skipped ones
+func ComparisonOutcomes(comparisons []Comparison) []elo.Outcome {
+	outcomes := make([]elo.Outcome, 0, len(comparisons))
+	for _, comparison := range comparisons {
+		if ranking := comparison.Outcome(); ranking != nil {
+			outcomes = append(outcomes, elo.Outcome{
+				Ranking:   ranking,
+				Positions: comparison.OutcomePositions(),
+				Unordered: comparison.IsPartial(),
+			})
+		}
+	}
+	return outcomes
+}
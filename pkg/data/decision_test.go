@@ -0,0 +1,90 @@
+package data
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecideProposals(t *testing.T) {
+	newProposals := func(scores ...float64) []Proposal {
+		proposals := make([]Proposal, len(scores))
+		for i, score := range scores {
+			proposals[i] = Proposal{ID: string(rune('a' + i)), Score: score}
+		}
+		return proposals
+	}
+
+	t.Run("bands follow the rank", func(t *testing.T) {
+		proposals := newProposals(1500, 1700, 1300, 1600, 1400)
+		decisions := DecideProposals(proposals, nil, ConvergenceConfig{TargetAccepted: 2, WaitlistSize: 2})
+
+		assert.Equal(t, DecisionAccepted, decisions["b"].Decision)
+		assert.Equal(t, DecisionAccepted, decisions["d"].Decision)
+		assert.Equal(t, DecisionWaitlist, decisions["a"].Decision)
+		assert.Equal(t, DecisionWaitlist, decisions["e"].Decision)
+		assert.Equal(t, DecisionRejected, decisions["c"].Decision)
+		for _, decision := range decisions {
+			assert.False(t, decision.Borderline)
+		}
+	})
+
+	t.Run("waitlist and accepted bands are clipped", func(t *testing.T) {
+		proposals := newProposals(1500, 1600, 1400)
+		decisions := DecideProposals(proposals, nil, ConvergenceConfig{TargetAccepted: 2, WaitlistSize: 5})
+		assert.Equal(t, DecisionWaitlist, decisions["c"].Decision)
+
+		decisions = DecideProposals(proposals, nil, ConvergenceConfig{TargetAccepted: 10, WaitlistSize: 5})
+		for _, decision := range decisions {
+			assert.Equal(t, DecisionAccepted, decision.Decision)
+			assert.False(t, decision.Borderline, "no cutoff when everything is accepted")
+		}
+
+		decisions = DecideProposals(proposals, nil, ConvergenceConfig{})
+		for _, decision := range decisions {
+			assert.Equal(t, DecisionRejected, decision.Decision)
+		}
+	})
+
+	t.Run("intervals overlapping the cutoff are borderline", func(t *testing.T) {
+		proposals := newProposals(1700, 1520, 1480, 1300)
+		uncertainties := map[string]float64{"a": 50, "b": 15, "c": 5, "d": 50}
+		decisions := DecideProposals(proposals, uncertainties, ConvergenceConfig{TargetAccepted: 2, WaitlistSize: 1})
+
+		assert.False(t, decisions["a"].Borderline)
+		assert.True(t, decisions["b"].Borderline)
+		assert.False(t, decisions["c"].Borderline)
+		assert.False(t, decisions["d"].Borderline)
+		assert.InDelta(t, 1520-1.96*15, decisions["b"].Low, 1e-9)
+		assert.InDelta(t, 1520+1.96*15, decisions["b"].High, 1e-9)
+	})
+
+	t.Run("ties at the cutoff are borderline", func(t *testing.T) {
+		proposals := newProposals(1600, 1500, 1500)
+		decisions := DecideProposals(proposals, nil, ConvergenceConfig{TargetAccepted: 2})
+
+		assert.True(t, decisions["b"].Borderline)
+		assert.True(t, decisions["c"].Borderline)
+		assert.Equal(t, DecisionRejected, decisions["c"].Decision)
+	})
+}
+
+func TestRatingUncertainties(t *testing.T) {
+	proposals := []Proposal{{ID: "a", Score: 1500, Deviation: 80}, {ID: "b", Score: 1500}, {ID: "c", Score: 1500}}
+	comparisons := []Comparison{
+		{ProposalIDs: []string{"a", "b"}, WinnerID: "a", Method: MethodPairwise, Timestamp: time.Now()},
+		{ProposalIDs: []string{"b", "c"}, WinnerID: "b", Method: MethodPairwise, Timestamp: time.Now()},
+		{ProposalIDs: []string{"c", "a"}, WinnerID: "c", Method: MethodPairwise, Timestamp: time.Now()},
+		{ProposalIDs: []string{"a", "b"}, Skipped: true, Method: MethodPairwise, Timestamp: time.Now()},
+	}
+
+	uncertainties := RatingUncertainties(proposals, comparisons, 1500)
+	require.Len(t, uncertainties, 3)
+	assert.Equal(t, 80.0, uncertainties["a"], "tracked deviation takes precedence")
+	assert.Greater(t, uncertainties["b"], 0.0)
+	assert.Greater(t, uncertainties["c"], 0.0)
+
+	assert.Len(t, ComparisonOutcomes(comparisons), 3, "skipped comparisons carry no outcome")
+}
@@ -0,0 +1,453 @@
+// Package data provides headless export of session rankings.
+// Rankings are written to a new file in one of several formats, sorted per the
+// session's ExportConfig, without touching the input CSV. JSON and YAML exports
+// are structured documents for downstream tools; the other formats are tables.
+package data
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pashagolub/confelo/pkg/elo"
+)
+
+// ErrUnsupportedExportFormat is returned for export formats without a writer
+var ErrUnsupportedExportFormat = errors.New("unsupported export format")
+
+// Export formats of ranked proposals
+const (
+	ExportFormatCSV      = "csv"
+	ExportFormatJSON     = "json"
+	ExportFormatYAML     = "yaml"
+	ExportFormatMarkdown = "markdown"
+	ExportFormatHTML     = "html"
+)
+
+// RankedProposal is a proposal with its position and statistics in the final ranking
+type RankedProposal struct {
+	Rank        int      // Position by rating (1 = best)
+	Proposal    Proposal // Proposal with its current rating in Score
+	ExportScore float64  // Rating converted to the output scale
+	Confidence  float64  // Confidence in the rating (0-100)
+	Comparisons int      // Number of comparisons the proposal took part in
+	Decision    Decision // Accept/waitlist/reject band of the proposal's rank
+	Borderline  bool     // Whether the rating interval overlaps the acceptance cutoff
+	Group       string   // Group the proposal is ranked in (when grouping is configured)
+	GroupRank   int      // Position within the group (1 = best)
+	Bucket      Bucket   // Triage bucket of the proposal (empty when not triaged)
+}
+
+// RankSession ranks the session's proposals by rating and orders them per the export configuration
+func RankSession(session *Session) []RankedProposal {
+	session.mutex.RLock()
+	proposals := make([]Proposal, len(session.Proposals))
+	copy(proposals, session.Proposals)
+	counts := make(map[string]int, len(session.ComparisonCounts))
+	for id, count := range session.ComparisonCounts {
+		counts[id] = count
+	}
+	history := make([]Comparison, len(session.CompletedComparisons))
+	copy(history, session.CompletedComparisons)
+	triage := make(map[string]Bucket, len(session.Triage))
+	for id, bucket := range session.Triage {
+		triage[id] = bucket
+	}
+	config := session.Config
+	session.mutex.RUnlock()
+
+	uncertainties := RatingUncertainties(proposals, history, config.Elo.InitialRating)
+	decisions := DecideGroupedProposals(proposals, uncertainties, config.Convergence, config.Groups)
+
+	ranks := rankByScore(proposals)
+	rankings := make([]RankedProposal, len(proposals))
+	for i, proposal := range proposals {
+		rankings[i] = RankedProposal{
+			Rank:        ranks[proposal.ID],
+			Proposal:    proposal,
+			ExportScore: config.Elo.CalculateExportScore(proposal.Score),
+			Confidence:  ProposalConfidence(proposal, counts[proposal.ID], proposals),
+			Comparisons: counts[proposal.ID],
+			Decision:    decisions[proposal.ID].Decision,
+			Borderline:  decisions[proposal.ID].Borderline,
+			Bucket:      triage[proposal.ID],
+		}
+		if config.Groups.Enabled() {
+			rankings[i].Group = config.Groups.GroupOf(proposal)
+			rankings[i].GroupRank = decisions[proposal.ID].Rank
+		}
+	}
+
+	sortRankings(rankings, config.Export)
+	if config.Groups.Enabled() {
+		// Per-group rankings: groups in name order, the configured order within each group
+		sort.SliceStable(rankings, func(i, j int) bool {
+			return rankings[i].Group < rankings[j].Group
+		})
+	}
+	return rankings
+}
+
+// sortRankings orders rankings by the configured field and direction (ties keep rank order)
+func sortRankings(rankings []RankedProposal, config ExportConfig) {
+	sort.SliceStable(rankings, func(i, j int) bool {
+		a, b := rankings[i], rankings[j]
+		var cmp int
+		switch config.SortBy {
+		case "title":
+			cmp = strings.Compare(a.Proposal.Title, b.Proposal.Title)
+		case "speaker":
+			cmp = strings.Compare(a.Proposal.Speaker, b.Proposal.Speaker)
+		case "id":
+			cmp = strings.Compare(a.Proposal.ID, b.Proposal.ID)
+		default:
+			// Higher ratings have lower ranks, so descending rating means ascending rank
+			cmp = b.Rank - a.Rank
+		}
+
+		if cmp == 0 {
+			return a.Rank < b.Rank
+		}
+		if config.SortOrder == "asc" {
+			return cmp < 0
+		}
+		return cmp > 0
+	})
+}
+
+// ProposalConfidence estimates the confidence in a proposal's rating as a percentage.
+// Glicko-2 ratings derive it from the rating deviation; Elo ratings from the number
+// of comparisons relative to the size of the proposal set, lowered when the rating
+// ties with several other proposals.
+func ProposalConfidence(proposal Proposal, comparisons int, proposals []Proposal) float64 {
+	if proposal.Deviation > 0 {
+		initialDeviation := elo.DefaultGlicko2Config().InitialDeviation
+		return 100.0 * math.Max(0, math.Min(1, 1-proposal.Deviation/initialDeviation))
+	}
+
+	// Small datasets need fewer comparisons per proposal for a good confidence
+	totalProposals := len(proposals)
+	var targetComparisons float64
+	switch {
+	case totalProposals <= 5:
+		targetComparisons = 2.5
+	case totalProposals <= 20:
+		targetComparisons = 4.5
+	case totalProposals <= 50:
+		targetComparisons = 7.0
+	default:
+		targetComparisons = 10.0
+	}
+
+	// confidence = 100 * (1 - e^(-count/target)), e.g. for target=4.5: 3 comparisons≈48%, 5≈67%, 10≈89%
+	confidence := 100.0 * (1.0 - math.Exp(-float64(comparisons)/targetComparisons))
+
+	// Very few comparisons are penalised, less strictly for small datasets
+	if totalProposals <= 5 {
+		if comparisons < 2 {
+			confidence *= 0.85
+		}
+	} else if comparisons < 3 {
+		confidence *= 0.7
+	}
+
+	// Two or more other proposals within one rating point indicate an unresolved tie
+	similar := 0
+	for _, other := range proposals {
+		if other.ID != proposal.ID && math.Abs(other.Score-proposal.Score) <= 1.0 {
+			similar++
+		}
+	}
+	if similar >= 2 {
+		if totalProposals <= 5 && comparisons >= 2 {
+			confidence *= 0.9
+		} else if comparisons < 5 {
+			confidence *= 0.8
+		}
+	}
+
+	return math.Min(confidence, 100.0)
+}
+
+// SetOutputScale changes the scale ratings are converted to on export
+func (s *Session) SetOutputScale(outputMin, outputMax float64, useDecimals bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.Config.Elo.OutputMin = outputMin
+	s.Config.Elo.OutputMax = outputMax
+	s.Config.Elo.UseDecimals = useDecimals
+	s.UpdatedAt = time.Now()
+}
+
+// ExportRankings writes the session's ranking to a new file in the given format.
+// The file is written atomically; the input CSV is never modified.
+func ExportRankings(session *Session, filename, format string) error {
+	return exportRankings(session, RankSession(session), filename, format)
+}
+
+// ExportRankingsOf writes the ranking of the listed proposals to a new file in the given
+// format, e.g. the proposals shown by a filter; they keep their rank among all proposals
+func ExportRankingsOf(session *Session, proposalIDs []string, filename, format string) error {
+	listed := make(map[string]bool, len(proposalIDs))
+	for _, id := range proposalIDs {
+		listed[id] = true
+	}
+
+	rankings := make([]RankedProposal, 0, len(proposalIDs))
+	for _, ranking := range RankSession(session) {
+		if listed[ranking.Proposal.ID] {
+			rankings = append(rankings, ranking)
+		}
+	}
+	return exportRankings(session, rankings, filename, format)
+}
+
+// exportRankings writes rankings to a new file atomically
+func exportRankings(session *Session, rankings []RankedProposal, filename, format string) error {
+	tempFile := filename + ".tmp"
+	file, err := os.Create(tempFile)
+	if err != nil {
+		return fmt.Errorf("cannot create temp file: %w", err)
+	}
+
+	err = writeRankings(file, session, rankings, format)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(tempFile)
+		return err
+	}
+
+	if err := os.Rename(tempFile, filename); err != nil {
+		_ = os.Remove(tempFile)
+		return fmt.Errorf("failed to rename temporary file: %w", err)
+	}
+
+	return nil
+}
+
+// WriteRankings writes the session's ranking in the given format.
+// Tabular formats (CSV, Markdown, HTML) hold one row per proposal; structured
+// formats (JSON, YAML) add a session header and keep values typed.
+func WriteRankings(w io.Writer, session *Session, format string) error {
+	return writeRankings(w, session, RankSession(session), format)
+}
+
+// writeRankings writes rankings of the session's proposals in the given format
+func writeRankings(w io.Writer, session *Session, rankings []RankedProposal, format string) error {
+	config := session.Config
+
+	switch format {
+	case ExportFormatCSV:
+		return newRankingTable(rankings, config).writeCSV(w, config.CSV.Delimiter)
+	case ExportFormatMarkdown:
+		return newRankingTable(rankings, config).writeMarkdown(w)
+	case ExportFormatHTML:
+		return newRankingTable(rankings, config).writeHTML(w)
+	case ExportFormatJSON:
+		return writeJSON(w, NewExportDocument(session, rankings))
+	case ExportFormatYAML:
+		return writeYAML(w, NewExportDocument(session, rankings))
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedExportFormat, format)
+	}
+}
+
+// rankingTable holds formatted ranking cells shared by all export formats
+type rankingTable struct {
+	headers []string
+	rows    [][]string
+}
+
+// rankingColumns are the fixed leading columns of every export
+var rankingColumns = []string{"rank", "id", "title", "speaker", "elo_score", "scaled_score", "confidence", "comparisons", "decision", "borderline"}
+
+// groupRankColumn holds the position within the group when grouping is configured
+const groupRankColumn = "group_rank"
+
+// bucketColumn holds the triage bucket when any proposal was triaged
+const bucketColumn = "bucket"
+
+// labelsColumn holds the reviewer labels (separated by "; ") when any proposal was labelled
+const labelsColumn = "labels"
+
+// noteColumn returns the column holding reviewer notes: the CSV comment column, or "comments"
+func noteColumn(config CSVConfig) string {
+	if config.CommentColumn != "" {
+		return config.CommentColumn
+	}
+	return "comments"
+}
+
+// newRankingTable formats rankings into cells, adding group, triage, note, label and metadata columns when configured
+func newRankingTable(rankings []RankedProposal, config SessionConfig) *rankingTable {
+	groupColumns := make([]string, 0)
+	if config.Groups.Enabled() {
+		groupColumns = append(groupColumns, config.Groups.Column, groupRankColumn)
+	}
+	triaged, noted, labelled := false, false, false
+	for _, ranking := range rankings {
+		triaged = triaged || ranking.Bucket != BucketNone
+		noted = noted || ranking.Proposal.Comment != ""
+		labelled = labelled || len(ranking.Proposal.Labels) > 0
+	}
+	if triaged {
+		groupColumns = append(groupColumns, bucketColumn)
+	}
+	if noted {
+		groupColumns = append(groupColumns, noteColumn(config.CSV))
+	}
+	if labelled {
+		groupColumns = append(groupColumns, labelsColumn)
+	}
+
+	metadataKeys := make([]string, 0)
+	if config.Export.IncludeMetadata {
+		// Columns already exported (or superseded by the rating) are not repeated
+		seen := map[string]bool{
+			config.CSV.IDColumn:      true,
+			config.CSV.TitleColumn:   true,
+			config.CSV.SpeakerColumn: true,
+			config.CSV.ScoreColumn:   true,
+			config.CSV.CommentColumn: true,
+		}
+		for _, column := range append(append([]string{}, rankingColumns...), groupColumns...) {
+			seen[column] = true
+		}
+		for _, ranking := range rankings {
+			for key := range ranking.Proposal.Metadata {
+				if !seen[key] {
+					seen[key] = true
+					metadataKeys = append(metadataKeys, key)
+				}
+			}
+		}
+		sort.Strings(metadataKeys)
+	}
+
+	decimals := config.Export.RoundDecimals
+	scoreDecimals := decimals
+	if !config.Elo.UseDecimals {
+		scoreDecimals = 0
+	}
+
+	table := &rankingTable{
+		headers: append(append(append([]string{}, rankingColumns...), groupColumns...), metadataKeys...),
+		rows:    make([][]string, len(rankings)),
+	}
+	for i, ranking := range rankings {
+		row := []string{
+			strconv.Itoa(ranking.Rank),
+			ranking.Proposal.ID,
+			ranking.Proposal.Title,
+			ranking.Proposal.Speaker,
+			strconv.FormatFloat(ranking.Proposal.Score, 'f', decimals, 64),
+			strconv.FormatFloat(ranking.ExportScore, 'f', scoreDecimals, 64),
+			strconv.FormatFloat(ranking.Confidence, 'f', decimals, 64),
+			strconv.Itoa(ranking.Comparisons),
+			string(ranking.Decision),
+			strconv.FormatBool(ranking.Borderline),
+		}
+		if config.Groups.Enabled() {
+			row = append(row, ranking.Group, strconv.Itoa(ranking.GroupRank))
+		}
+		if triaged {
+			row = append(row, string(ranking.Bucket))
+		}
+		if noted {
+			row = append(row, ranking.Proposal.Comment)
+		}
+		if labelled {
+			row = append(row, strings.Join(ranking.Proposal.Labels, "; "))
+		}
+		for _, key := range metadataKeys {
+			row = append(row, ranking.Proposal.Metadata[key])
+		}
+		table.rows[i] = row
+	}
+
+	return table
+}
+
+// writeCSV writes the table as CSV with the session's delimiter
+func (t *rankingTable) writeCSV(w io.Writer, delimiter string) error {
+	writer := csv.NewWriter(w)
+	if delimiter != "" {
+		writer.Comma = rune(delimiter[0])
+	}
+
+	if err := writer.Write(t.headers); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+	if err := writer.WriteAll(t.rows); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return nil
+}
+
+// writeMarkdown writes the table as a GitHub-flavoured Markdown table
+func (t *rankingTable) writeMarkdown(w io.Writer) error {
+	escape := strings.NewReplacer("|", `\|`, "\r\n", " ", "\n", " ")
+	writeRow := func(cells []string) error {
+		escaped := make([]string, len(cells))
+		for i, cell := range cells {
+			escaped[i] = escape.Replace(cell)
+		}
+		_, err := fmt.Fprintf(w, "| %s |\n", strings.Join(escaped, " | "))
+		return err
+	}
+
+	separators := make([]string, len(t.headers))
+	for i := range separators {
+		separators[i] = "---"
+	}
+
+	for _, row := range append([][]string{t.headers, separators}, t.rows...) {
+		if err := writeRow(row); err != nil {
+			return fmt.Errorf("failed to write Markdown: %w", err)
+		}
+	}
+	return nil
+}
+
+// rankingHTMLTemplate renders the table as a standalone HTML page
+var rankingHTMLTemplate = template.Must(template.New("rankings").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Proposal Rankings</title>
+</head>
+<body>
+<table>
+<thead>
+<tr>{{range .Headers}}<th>{{.}}</th>{{end}}</tr>
+</thead>
+<tbody>
+{{range .Rows}}<tr>{{range .}}<td>{{.}}</td>{{end}}</tr>
+{{end}}</tbody>
+</table>
+</body>
+</html>
+`))
+
+// writeHTML writes the table as an HTML page with escaped cells
+func (t *rankingTable) writeHTML(w io.Writer) error {
+	err := rankingHTMLTemplate.Execute(w, struct {
+		Headers []string
+		Rows    [][]string
+	}{t.headers, t.rows})
+	if err != nil {
+		return fmt.Errorf("failed to write HTML: %w", err)
+	}
+	return nil
+}
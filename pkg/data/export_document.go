@@ -0,0 +1,257 @@
+// Package data provides structured (JSON and YAML) ranking exports.
+// Unlike the tabular formats they keep values typed and carry the session
+// header, every proposal's metadata, conflict tags and comparison statistics.
+package data
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ExportDocument is the structured export of a session's ranking
+type ExportDocument struct {
+	Session   ExportSessionHeader `json:"session"`
+	Proposals []ExportedProposal  `json:"proposals"`
+}
+
+// ExportSessionHeader describes the session a structured export was taken from
+type ExportSessionHeader struct {
+	Name             string        `json:"name"`
+	Status           SessionStatus `json:"status"`
+	InputCSVPath     string        `json:"input_csv_path"`
+	CreatedAt        time.Time     `json:"created_at"`
+	UpdatedAt        time.Time     `json:"updated_at"`
+	ExportedAt       time.Time     `json:"exported_at"`
+	Config           SessionConfig `json:"config"`
+	TotalComparisons int           `json:"total_comparisons"`
+	ConvergenceScore float64       `json:"convergence_score"` // Overall convergence indicator 0-1
+	Groups           []ExportGroup `json:"groups,omitempty"`  // Per-group cut lines (when grouping is configured)
+}
+
+// ExportGroup summarises the acceptance cut line of one group
+type ExportGroup struct {
+	Name        string   `json:"name"`         // Value of the group column
+	Quota       int      `json:"quota"`        // Number of proposals to accept from the group
+	Proposals   int      `json:"proposals"`    // Number of proposals in the group
+	Accepted    int      `json:"accepted"`     // Number of proposals accepted from the group
+	CutoffScore *float64 `json:"cutoff_score"` // Rating of the last accepted proposal (null if none)
+}
+
+// ExportedProposal is a ranked proposal in a structured export
+type ExportedProposal struct {
+	Rank          int               `json:"rank"`                   // Position by rating (1 = best)
+	ID            string            `json:"id"`                     // Unique identifier (from CSV)
+	Title         string            `json:"title"`                  // Talk title
+	Abstract      string            `json:"abstract,omitempty"`     // Full description (optional)
+	Speaker       string            `json:"speaker,omitempty"`      // Presenter information (optional)
+	OriginalScore *float64          `json:"original_score"`         // Score from the input CSV (null if none)
+	EloScore      float64           `json:"elo_score"`              // Final rating
+	ScaledScore   *float64          `json:"scaled_score,omitempty"` // Rating on the output scale (when scaling is enabled)
+	Deviation     float64           `json:"deviation,omitempty"`    // Rating deviation (Glicko-2 only)
+	Confidence    float64           `json:"confidence"`             // Confidence in the rating (0-100)
+	Stats         ComparisonStats   `json:"comparison_stats"`       // Comparison outcomes of the proposal
+	Decision      Decision          `json:"decision"`               // Accept/waitlist/reject band
+	Borderline    bool              `json:"borderline"`             // Rating interval overlaps the acceptance cutoff
+	Group         string            `json:"group,omitempty"`        // Group the proposal is ranked in
+	GroupRank     int               `json:"group_rank,omitempty"`   // Position within the group (1 = best)
+	Bucket        Bucket            `json:"bucket,omitempty"`       // Triage bucket (strong/maybe/weak)
+	Note          string            `json:"note,omitempty"`         // Reviewer note
+	Reasons       []string          `json:"reasons,omitempty"`      // Notes of the comparisons it took part in, oldest first
+	Labels        []string          `json:"labels,omitempty"`       // Reviewer labels
+	Metadata      map[string]string `json:"metadata,omitempty"`     // Additional CSV columns (when metadata is included)
+	ConflictTags  []string          `json:"conflict_tags,omitempty"`
+}
+
+// ComparisonStats summarises the comparisons a proposal took part in
+type ComparisonStats struct {
+	Comparisons int `json:"comparisons"` // Comparisons the proposal took part in
+	Wins        int `json:"wins"`        // Comparisons where it was picked as best
+	Losses      int `json:"losses"`      // Comparisons where another proposal was picked
+	Draws       int `json:"draws"`       // Comparisons judged equally good
+}
+
+// NewExportDocument builds the structured export of a session from its rankings
+func NewExportDocument(session *Session, rankings []RankedProposal) *ExportDocument {
+	history := session.GetComparisonHistory()
+	metrics := session.GetConvergenceMetrics()
+
+	session.mutex.RLock()
+	header := ExportSessionHeader{
+		Name:             session.Name,
+		Status:           session.Status,
+		InputCSVPath:     session.InputCSVPath,
+		CreatedAt:        session.CreatedAt,
+		UpdatedAt:        session.UpdatedAt,
+		ExportedAt:       time.Now(),
+		Config:           session.Config,
+		TotalComparisons: len(history),
+	}
+	session.mutex.RUnlock()
+	if metrics != nil {
+		header.ConvergenceScore = metrics.ConvergenceScore
+	}
+
+	stats := comparisonStats(history)
+	reasons := comparisonNotes(history)
+	config := header.Config
+	round := func(value float64) float64 {
+		scale := math.Pow(10, float64(config.Export.RoundDecimals))
+		return math.Round(value*scale) / scale
+	}
+
+	proposals := make([]ExportedProposal, len(rankings))
+	for i, ranking := range rankings {
+		proposal := ranking.Proposal
+		exported := ExportedProposal{
+			Rank:          ranking.Rank,
+			ID:            proposal.ID,
+			Title:         proposal.Title,
+			Abstract:      proposal.Abstract,
+			Speaker:       proposal.Speaker,
+			OriginalScore: proposal.OriginalScore,
+			EloScore:      round(proposal.Score),
+			Deviation:     round(proposal.Deviation),
+			Confidence:    round(ranking.Confidence),
+			Stats:         stats[proposal.ID],
+			Decision:      ranking.Decision,
+			Borderline:    ranking.Borderline,
+			Group:         ranking.Group,
+			GroupRank:     ranking.GroupRank,
+			Bucket:        ranking.Bucket,
+			Note:          proposal.Comment,
+			Reasons:       reasons[proposal.ID],
+			Labels:        proposal.Labels,
+			ConflictTags:  proposal.ConflictTags,
+		}
+		exported.Stats.Comparisons = ranking.Comparisons
+		if config.Export.ScaleOutput {
+			scaled := round(ranking.ExportScore)
+			exported.ScaledScore = &scaled
+		}
+		if config.Export.IncludeMetadata {
+			exported.Metadata = proposal.Metadata
+		}
+		proposals[i] = exported
+	}
+	if config.Groups.Enabled() {
+		header.Groups = exportGroups(rankings, config.Groups, round)
+	}
+
+	return &ExportDocument{Session: header, Proposals: proposals}
+}
+
+// exportGroups summarises every group's quota and cut line, in group name order
+func exportGroups(rankings []RankedProposal, config GroupConfig, round func(float64) float64) []ExportGroup {
+	byName := make(map[string]*ExportGroup)
+	names := make([]string, 0)
+	for _, ranking := range rankings {
+		group, exists := byName[ranking.Group]
+		if !exists {
+			group = &ExportGroup{Name: ranking.Group, Quota: config.QuotaFor(ranking.Group)}
+			byName[ranking.Group] = group
+			names = append(names, ranking.Group)
+		}
+		group.Proposals++
+		if ranking.Decision != DecisionAccepted {
+			continue
+		}
+		group.Accepted++
+		if score := round(ranking.Proposal.Score); group.CutoffScore == nil || score < *group.CutoffScore {
+			group.CutoffScore = &score
+		}
+	}
+
+	sort.Strings(names)
+	groups := make([]ExportGroup, len(names))
+	for i, name := range names {
+		groups[i] = *byName[name]
+	}
+	return groups
+}
+
+// comparisonStats counts wins, losses and draws of every proposal in the history;
+// every proposal picked by a pick-best comparison counts as a win
+func comparisonStats(history []Comparison) map[string]ComparisonStats {
+	stats := make(map[string]ComparisonStats)
+	for _, comparison := range history {
+		if comparison.Outcome() == nil {
+			continue // Skipped comparisons have no result
+		}
+		for _, id := range comparison.ProposalIDs {
+			entry := stats[id]
+			switch {
+			case comparison.Draw:
+				entry.Draws++
+			case comparison.WinnerID == id, comparison.Method == MethodPickBest && comparison.isSelected(id):
+				entry.Wins++
+			default:
+				entry.Losses++
+			}
+			stats[id] = entry
+		}
+	}
+	return stats
+}
+
+// comparisonNotes collects the notes of decided comparisons by the proposals they involve
+func comparisonNotes(history []Comparison) map[string][]string {
+	notes := make(map[string][]string)
+	for _, comparison := range history {
+		if comparison.Note == "" || comparison.Outcome() == nil {
+			continue
+		}
+		for _, id := range comparison.ProposalIDs {
+			notes[id] = append(notes[id], comparison.Note)
+		}
+	}
+	return notes
+}
+
+// writeJSON writes a structured export as indented JSON
+func writeJSON(w io.Writer, document *ExportDocument) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(document); err != nil {
+		return fmt.Errorf("failed to write JSON: %w", err)
+	}
+	return nil
+}
+
+// writeYAML writes a structured export as YAML with the same keys as the JSON export.
+// The document is encoded as JSON first, which YAML parses as a subset, so field
+// names, order and types follow the json tags without duplicating them as yaml tags.
+func writeYAML(w io.Writer, document *ExportDocument) error {
+	var buf bytes.Buffer
+	if err := writeJSON(&buf, document); err != nil {
+		return err
+	}
+
+	var node yaml.Node
+	if err := yaml.Unmarshal(buf.Bytes(), &node); err != nil {
+		return fmt.Errorf("failed to write YAML: %w", err)
+	}
+	useBlockStyle(&node)
+
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&node); err != nil {
+		return fmt.Errorf("failed to write YAML: %w", err)
+	}
+	return encoder.Close()
+}
+
+// useBlockStyle drops the JSON flow and quoting styles so the encoder picks YAML defaults;
+// strings that would read as another type stay quoted
+func useBlockStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		useBlockStyle(child)
+	}
+}
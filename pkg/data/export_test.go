@@ -0,0 +1,353 @@
+package data
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestRankSession(t *testing.T) {
+	newExportSession := func(t *testing.T) *Session {
+		proposals := createTestProposals()
+		proposals[0].Score = 1400
+		proposals[1].Score = 1600
+		proposals[2].Score = 1500
+		proposals[1].Metadata = map[string]string{"track": "Backend", "title": "Test Proposal 2"}
+		session, err := NewSession("export", proposals, createTestConfig(), "test.csv")
+		require.NoError(t, err)
+		session.ComparisonCounts["prop2"] = 4
+		return session
+	}
+
+	t.Run("ranks by rating best first", func(t *testing.T) {
+		rankings := RankSession(newExportSession(t))
+		require.Len(t, rankings, 3)
+
+		assert.Equal(t, "prop2", rankings[0].Proposal.ID)
+		assert.Equal(t, 1, rankings[0].Rank)
+		assert.Equal(t, "prop1", rankings[2].Proposal.ID)
+		assert.Equal(t, 3, rankings[2].Rank)
+		assert.Equal(t, 4, rankings[0].Comparisons)
+		assert.Greater(t, rankings[0].ExportScore, rankings[1].ExportScore)
+		assert.Greater(t, rankings[0].Confidence, rankings[1].Confidence)
+	})
+
+	t.Run("honours sort configuration", func(t *testing.T) {
+		session := newExportSession(t)
+		session.Config.Export.SortOrder = "asc"
+		rankings := RankSession(session)
+		assert.Equal(t, "prop1", rankings[0].Proposal.ID)
+		assert.Equal(t, 3, rankings[0].Rank)
+
+		session.Config.Export.SortBy = "id"
+		rankings = RankSession(session)
+		assert.Equal(t, []string{"prop1", "prop2", "prop3"}, []string{
+			rankings[0].Proposal.ID, rankings[1].Proposal.ID, rankings[2].Proposal.ID,
+		})
+	})
+
+	t.Run("writes tabular formats", func(t *testing.T) {
+		session := newExportSession(t)
+
+		var buf bytes.Buffer
+		require.NoError(t, WriteRankings(&buf, session, ExportFormatCSV))
+		records, err := csv.NewReader(&buf).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 4)
+		assert.Equal(t, append(append([]string{}, rankingColumns...), "track"), records[0])
+		assert.Equal(t, []string{"1", "prop2", "Test Proposal 2", "Speaker 2", "1600.00"}, records[1][:5])
+		assert.Equal(t, "Backend", records[1][len(rankingColumns)])
+
+		buf.Reset()
+		require.NoError(t, WriteRankings(&buf, session, ExportFormatMarkdown))
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		require.Len(t, lines, 5)
+		assert.True(t, strings.HasPrefix(lines[0], "| rank | id | title |"))
+		assert.True(t, strings.HasPrefix(lines[2], "| 1 | prop2 |"))
+
+		buf.Reset()
+		session.Proposals[0].Title = "<script>"
+		require.NoError(t, WriteRankings(&buf, session, ExportFormatHTML))
+		assert.Contains(t, buf.String(), "<th>scaled_score</th>")
+		assert.Contains(t, buf.String(), "&lt;script&gt;")
+		assert.NotContains(t, buf.String(), "<script>")
+
+		err = WriteRankings(&buf, session, "pdf")
+		assert.ErrorIs(t, err, ErrUnsupportedExportFormat)
+	})
+
+	t.Run("labels decision bands", func(t *testing.T) {
+		session := newExportSession(t)
+		session.Config.Convergence.TargetAccepted = 1
+		session.Config.Convergence.WaitlistSize = 1
+
+		rankings := RankSession(session)
+		assert.Equal(t, []Decision{DecisionAccepted, DecisionWaitlist, DecisionRejected}, []Decision{
+			rankings[0].Decision, rankings[1].Decision, rankings[2].Decision,
+		})
+
+		var buf bytes.Buffer
+		require.NoError(t, WriteRankings(&buf, session, ExportFormatCSV))
+		records, err := csv.NewReader(&buf).ReadAll()
+		require.NoError(t, err)
+		assert.Equal(t, "accepted", records[1][8])
+		assert.Equal(t, "rejected", records[3][8])
+	})
+
+	t.Run("ranks per group", func(t *testing.T) {
+		session := newExportSession(t)
+		session.Proposals[0].Metadata = map[string]string{"track": "Backend"}
+		session.Proposals[2].Metadata = map[string]string{"track": "Frontend"}
+		session.SetGroupConfig(GroupConfig{Column: "track", Quotas: map[string]int{"Backend": 1, "Frontend": 1}})
+
+		rankings := RankSession(session)
+		assert.Equal(t, []string{"prop2", "prop1", "prop3"}, []string{
+			rankings[0].Proposal.ID, rankings[1].Proposal.ID, rankings[2].Proposal.ID,
+		})
+		assert.Equal(t, []int{1, 2, 1}, []int{rankings[0].GroupRank, rankings[1].GroupRank, rankings[2].GroupRank})
+		assert.Equal(t, DecisionAccepted, rankings[2].Decision, "best of its own group")
+
+		var buf bytes.Buffer
+		require.NoError(t, WriteRankings(&buf, session, ExportFormatCSV))
+		records, err := csv.NewReader(&buf).ReadAll()
+		require.NoError(t, err)
+		assert.Equal(t, append(append([]string{}, rankingColumns...), "track", groupRankColumn), records[0])
+		assert.Equal(t, []string{"Frontend", "1"}, records[3][len(rankingColumns):])
+
+		document := NewExportDocument(session, rankings)
+		require.Len(t, document.Session.Groups, 2)
+		backend := document.Session.Groups[0]
+		assert.Equal(t, "Backend", backend.Name)
+		assert.Equal(t, 2, backend.Proposals)
+		assert.Equal(t, 1, backend.Accepted)
+		require.NotNil(t, backend.CutoffScore)
+		assert.Equal(t, 1600.0, *backend.CutoffScore)
+		assert.Equal(t, "track", document.Session.Config.Groups.Column)
+	})
+
+	t.Run("metadata columns are optional", func(t *testing.T) {
+		session := newExportSession(t)
+		session.Config.Export.IncludeMetadata = false
+
+		var buf bytes.Buffer
+		require.NoError(t, WriteRankings(&buf, session, ExportFormatCSV))
+		records, err := csv.NewReader(&buf).ReadAll()
+		require.NoError(t, err)
+		assert.Equal(t, rankingColumns, records[0])
+	})
+
+	t.Run("triage buckets are exported", func(t *testing.T) {
+		session := newExportSession(t)
+		session.Config.Export.IncludeMetadata = false
+		require.NoError(t, session.SetBucket("prop1", BucketWeak))
+
+		rankings := RankSession(session)
+		assert.Equal(t, BucketWeak, rankings[2].Bucket)
+
+		var buf bytes.Buffer
+		require.NoError(t, WriteRankings(&buf, session, ExportFormatCSV))
+		records, err := csv.NewReader(&buf).ReadAll()
+		require.NoError(t, err)
+		assert.Equal(t, append(append([]string{}, rankingColumns...), bucketColumn), records[0])
+		assert.Equal(t, []string{"", "", "weak"}, []string{records[1][len(rankingColumns)], records[2][len(rankingColumns)], records[3][len(rankingColumns)]})
+
+		document := NewExportDocument(session, rankings)
+		assert.Equal(t, BucketWeak, document.Proposals[2].Bucket)
+	})
+
+	t.Run("reviewer notes are exported", func(t *testing.T) {
+		session := newExportSession(t)
+		session.Proposals[1].Metadata["comments"] = "From the CSV"
+		require.NoError(t, session.SetNote("prop2", "Committee favourite"))
+		comparison := createUndoTestComparison("c1", "prop2", "prop3", 1600, 1500)
+		comparison.Note = "Sharper abstract"
+		require.NoError(t, session.RecordComparison(comparison))
+
+		var buf bytes.Buffer
+		require.NoError(t, WriteRankings(&buf, session, ExportFormatCSV))
+		records, err := csv.NewReader(&buf).ReadAll()
+		require.NoError(t, err)
+		assert.Equal(t, append(append([]string{}, rankingColumns...), "comments", "track"), records[0])
+		assert.Equal(t, "Committee favourite", records[1][len(rankingColumns)])
+		assert.Equal(t, "", records[2][len(rankingColumns)])
+
+		document := NewExportDocument(session, RankSession(session))
+		for _, proposal := range document.Proposals {
+			switch proposal.ID {
+			case "prop2":
+				assert.Equal(t, "Committee favourite", proposal.Note)
+				assert.Equal(t, []string{"Sharper abstract"}, proposal.Reasons)
+			case "prop3":
+				assert.Equal(t, []string{"Sharper abstract"}, proposal.Reasons)
+			default:
+				assert.Empty(t, proposal.Note)
+				assert.Empty(t, proposal.Reasons)
+			}
+		}
+	})
+
+	t.Run("reviewer labels are exported", func(t *testing.T) {
+		session := newExportSession(t)
+		require.NoError(t, session.SetLabels("prop2", []string{"keynote", "beginner-friendly"}))
+
+		var buf bytes.Buffer
+		require.NoError(t, WriteRankings(&buf, session, ExportFormatCSV))
+		records, err := csv.NewReader(&buf).ReadAll()
+		require.NoError(t, err)
+		assert.Equal(t, append(append([]string{}, rankingColumns...), "labels", "track"), records[0])
+		assert.Equal(t, "keynote; beginner-friendly", records[1][len(rankingColumns)])
+		assert.Equal(t, "", records[2][len(rankingColumns)])
+
+		document := NewExportDocument(session, RankSession(session))
+		for _, proposal := range document.Proposals {
+			if proposal.ID == "prop2" {
+				assert.Equal(t, []string{"keynote", "beginner-friendly"}, proposal.Labels)
+			} else {
+				assert.Empty(t, proposal.Labels)
+			}
+		}
+	})
+
+	t.Run("export writes a new file", func(t *testing.T) {
+		output := filepath.Join(t.TempDir(), "ranked.md")
+		require.NoError(t, ExportRankings(newExportSession(t), output, ExportFormatMarkdown))
+
+		content, err := os.ReadFile(output)
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "| 1 | prop2 |")
+		_, err = os.Stat(output + ".tmp")
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("export of listed proposals keeps their overall rank", func(t *testing.T) {
+		output := filepath.Join(t.TempDir(), "shown.csv")
+		require.NoError(t, ExportRankingsOf(newExportSession(t), []string{"prop1", "missing"}, output, ExportFormatCSV))
+
+		file, err := os.Open(output)
+		require.NoError(t, err)
+		defer func() { _ = file.Close() }()
+		records, err := csv.NewReader(file).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		assert.Equal(t, []string{"3", "prop1"}, records[1][:2])
+	})
+}
+
+func TestExportDocument(t *testing.T) {
+	newDocumentSession := func(t *testing.T) *Session {
+		original := 4.5
+		proposals := createTestProposals()
+		proposals[0].OriginalScore = &original
+		proposals[0].Metadata = map[string]string{"track": "Backend", "level": "3"}
+		proposals[0].ConflictTags = []string{"acme"}
+		session, err := NewSession("document", proposals, createTestConfig(), "test.csv")
+		require.NoError(t, err)
+		session.SetStorageDirectory(t.TempDir()) // Completed comparisons auto-save
+
+		require.NoError(t, session.StartComparison([]string{"prop1", "prop2"}, MethodPairwise))
+		_, err = session.CompleteComparison("prop1", nil, false, "")
+		require.NoError(t, err)
+		require.NoError(t, session.RecordComparison(Comparison{
+			ID: "draw", ProposalIDs: []string{"prop1", "prop3"}, Draw: true, Method: MethodPairwise, Timestamp: time.Now(),
+		}))
+		return session
+	}
+
+	t.Run("carries session header and proposal details", func(t *testing.T) {
+		session := newDocumentSession(t)
+		document := NewExportDocument(session, RankSession(session))
+
+		assert.Equal(t, "document", document.Session.Name)
+		assert.Equal(t, 2, document.Session.TotalComparisons)
+		assert.Equal(t, session.Config, document.Session.Config)
+		require.Len(t, document.Proposals, 3)
+
+		top := document.Proposals[0]
+		assert.Equal(t, "prop1", top.ID)
+		assert.Equal(t, 1, top.Rank)
+		require.NotNil(t, top.OriginalScore)
+		assert.Equal(t, 4.5, *top.OriginalScore)
+		require.NotNil(t, top.ScaledScore)
+		assert.Equal(t, session.Config.Elo.CalculateExportScore(session.Proposals[0].Score), *top.ScaledScore)
+		assert.Equal(t, "Backend", top.Metadata["track"])
+		assert.Equal(t, []string{"acme"}, top.ConflictTags)
+		assert.Equal(t, ComparisonStats{Comparisons: 2, Wins: 1, Draws: 1}, top.Stats)
+		assert.Equal(t, DecisionAccepted, top.Decision)
+
+		for _, proposal := range document.Proposals {
+			if proposal.ID == "prop2" {
+				assert.Equal(t, ComparisonStats{Comparisons: 1, Losses: 1}, proposal.Stats)
+			}
+		}
+	})
+
+	t.Run("honours export configuration", func(t *testing.T) {
+		session := newDocumentSession(t)
+		session.Config.Export.IncludeMetadata = false
+		session.Config.Export.ScaleOutput = false
+		session.Config.Export.RoundDecimals = 0
+
+		document := NewExportDocument(session, RankSession(session))
+		top := document.Proposals[0]
+		assert.Nil(t, top.Metadata)
+		assert.Nil(t, top.ScaledScore)
+		assert.Equal(t, math.Round(session.Proposals[0].Score), top.EloScore)
+	})
+
+	t.Run("JSON and YAML round-trip with types", func(t *testing.T) {
+		session := newDocumentSession(t)
+
+		var buf bytes.Buffer
+		require.NoError(t, WriteRankings(&buf, session, ExportFormatJSON))
+		var fromJSON ExportDocument
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &fromJSON))
+		assert.Equal(t, "document", fromJSON.Session.Name)
+		assert.Equal(t, "3", fromJSON.Proposals[0].Metadata["level"])
+
+		buf.Reset()
+		require.NoError(t, WriteRankings(&buf, session, ExportFormatYAML))
+		assert.Contains(t, buf.String(), "session:\n  name: document\n")
+		assert.Contains(t, buf.String(), "level: \"3\"")
+
+		var fromYAML map[string]any
+		require.NoError(t, yaml.Unmarshal(buf.Bytes(), &fromYAML))
+		proposals := fromYAML["proposals"].([]any)
+		top := proposals[0].(map[string]any)
+		assert.Equal(t, 1, top["rank"])
+		assert.Equal(t, 4.5, top["original_score"])
+		assert.Equal(t, "3", top["metadata"].(map[string]any)["level"])
+		assert.Equal(t, 2, top["comparison_stats"].(map[string]any)["comparisons"])
+	})
+}
+
+func TestProposalConfidence(t *testing.T) {
+	proposals := createTestProposals()
+	proposals[0].Score = 1400
+	proposals[1].Score = 1600
+
+	none := ProposalConfidence(proposals[0], 0, proposals)
+	few := ProposalConfidence(proposals[0], 1, proposals)
+	many := ProposalConfidence(proposals[0], 6, proposals)
+	assert.Equal(t, 0.0, none)
+	assert.Greater(t, few, none)
+	assert.Greater(t, many, few)
+	assert.LessOrEqual(t, many, 100.0)
+
+	// Ties with two other proposals lower the confidence
+	tied := createTestProposals()
+	assert.Less(t, ProposalConfidence(tied[0], 3, tied), ProposalConfidence(proposals[0], 3, proposals))
+
+	// Glicko-2 deviation takes precedence over the comparison count
+	proposals[0].Deviation = 175
+	assert.Equal(t, 50.0, ProposalConfidence(proposals[0], 0, proposals))
+}
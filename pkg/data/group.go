@@ -0,0 +1,126 @@
+// Package data provides track-aware grouping of proposals.
+// Proposals are grouped by a metadata column (e.g. track); each group is ranked on
+// its own and accepts as many proposals as its quota allows.
+package data
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Enabled reports whether proposals are grouped
+func (g GroupConfig) Enabled() bool {
+	return g.Column != ""
+}
+
+// GroupOf returns the group a proposal belongs to (empty when the column is not set)
+func (g GroupConfig) GroupOf(proposal Proposal) string {
+	if !g.Enabled() {
+		return ""
+	}
+	return proposal.Metadata[g.Column]
+}
+
+// QuotaFor returns the number of proposals to accept from a group; group names match case-insensitively
+func (g GroupConfig) QuotaFor(group string) int {
+	if quota, ok := g.Quotas[group]; ok {
+		return quota
+	}
+	for name, quota := range g.Quotas {
+		if strings.EqualFold(name, group) {
+			return quota
+		}
+	}
+	return 0
+}
+
+// ParseGroupQuotas parses a comma-separated list of group=quota pairs (e.g. "dev=6,ops=4")
+func ParseGroupQuotas(list string) (map[string]int, error) {
+	quotas := make(map[string]int)
+	for _, entry := range strings.Split(list, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		// Split at the last '=' so group names may contain one
+		separator := strings.LastIndex(entry, "=")
+		if separator <= 0 {
+			return nil, fmt.Errorf("quota '%s' must have the form group=count", entry)
+		}
+		group := strings.TrimSpace(entry[:separator])
+		quota, err := strconv.Atoi(strings.TrimSpace(entry[separator+1:]))
+		if err != nil || quota < 0 {
+			return nil, fmt.Errorf("quota of group '%s' must be a non-negative integer", group)
+		}
+		if _, exists := quotas[group]; exists {
+			return nil, fmt.Errorf("duplicate quota for group '%s'", group)
+		}
+		quotas[group] = quota
+	}
+	return quotas, nil
+}
+
+// GroupProposals splits proposals by group, keeping their order within each group
+func GroupProposals(proposals []Proposal, config GroupConfig) map[string][]Proposal {
+	groups := make(map[string][]Proposal)
+	for _, proposal := range proposals {
+		group := config.GroupOf(proposal)
+		groups[group] = append(groups[group], proposal)
+	}
+	return groups
+}
+
+// GroupNames returns the sorted names of the groups present among the proposals
+func GroupNames(proposals []Proposal, config GroupConfig) []string {
+	groups := GroupProposals(proposals, config)
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// DecideGroupedProposals assigns decision bands within each group, accepting up to the group's
+// quota and waitlisting the next WaitlistSize proposals of every group. Without grouping the
+// whole ranking is decided against TargetAccepted.
+func DecideGroupedProposals(proposals []Proposal, uncertainties map[string]float64, convergence ConvergenceConfig, groups GroupConfig) map[string]ProposalDecision {
+	if !groups.Enabled() {
+		return DecideProposals(proposals, uncertainties, convergence)
+	}
+
+	decisions := make(map[string]ProposalDecision, len(proposals))
+	for group, members := range GroupProposals(proposals, groups) {
+		config := convergence
+		config.TargetAccepted = groups.QuotaFor(group)
+		for id, decision := range DecideProposals(members, uncertainties, config) {
+			decisions[id] = decision
+		}
+	}
+	return decisions
+}
+
+// GetProposalGroups returns the group of every proposal by ID (thread-safe copy)
+func (s *Session) GetProposalGroups() map[string]string {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	groups := make(map[string]string, len(s.Proposals))
+	for _, proposal := range s.Proposals {
+		groups[proposal.ID] = s.Config.Groups.GroupOf(proposal)
+	}
+	return groups
+}
+
+// SetGroupConfig replaces the session's grouping and per-group quotas
+func (s *Session) SetGroupConfig(config GroupConfig) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.Config.Groups = config
+	s.UpdatedAt = time.Now()
+}
@@ -0,0 +1,95 @@
+package data
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGroupQuotas(t *testing.T) {
+	quotas, err := ParseGroupQuotas(" dev=6, ops = 4,,DBA (45 minutes)=2")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"dev": 6, "ops": 4, "DBA (45 minutes)": 2}, quotas)
+
+	quotas, err = ParseGroupQuotas("")
+	require.NoError(t, err)
+	assert.Empty(t, quotas)
+
+	for _, invalid := range []string{"dev", "=3", "dev=x", "dev=-1", "dev=1,dev=2"} {
+		_, err := ParseGroupQuotas(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestGroupConfig(t *testing.T) {
+	config := GroupConfig{Column: "track", Quotas: map[string]int{"Dev": 2}}
+	proposal := Proposal{ID: "p", Metadata: map[string]string{"track": "dev"}}
+
+	assert.True(t, config.Enabled())
+	assert.Equal(t, "dev", config.GroupOf(proposal))
+	assert.Equal(t, 2, config.QuotaFor("dev"), "group names match case-insensitively")
+	assert.Equal(t, 0, config.QuotaFor("ops"))
+	assert.Equal(t, "", GroupConfig{}.GroupOf(proposal))
+
+	t.Run("validation", func(t *testing.T) {
+		assert.NoError(t, config.Validate())
+
+		invalid := []GroupConfig{
+			{Quotas: map[string]int{"dev": 1}},
+			{Column: "track", Quotas: map[string]int{"dev": -1}},
+			{Column: "track", CrossGroupRate: 1.5},
+		}
+		for _, group := range invalid {
+			assert.ErrorIs(t, group.Validate(), ErrInvalidGroupConfig)
+		}
+	})
+}
+
+func TestDecideGroupedProposals(t *testing.T) {
+	proposals := []Proposal{
+		{ID: "d1", Score: 1700, Metadata: map[string]string{"track": "dev"}},
+		{ID: "d2", Score: 1600, Metadata: map[string]string{"track": "dev"}},
+		{ID: "d3", Score: 1500, Metadata: map[string]string{"track": "dev"}},
+		{ID: "o1", Score: 1400, Metadata: map[string]string{"track": "ops"}},
+		{ID: "o2", Score: 1300, Metadata: map[string]string{"track": "ops"}},
+		{ID: "c1", Score: 1800, Metadata: map[string]string{"track": "community"}},
+	}
+	groups := GroupConfig{Column: "track", Quotas: map[string]int{"dev": 1, "ops": 1}}
+	convergence := ConvergenceConfig{TargetAccepted: 10, WaitlistSize: 1}
+
+	decisions := DecideGroupedProposals(proposals, nil, convergence, groups)
+	assert.Equal(t, DecisionAccepted, decisions["d1"].Decision)
+	assert.Equal(t, DecisionWaitlist, decisions["d2"].Decision)
+	assert.Equal(t, DecisionRejected, decisions["d3"].Decision)
+	assert.Equal(t, 3, decisions["d3"].Rank)
+
+	// Each group has its own cut line, whatever the ratings in other groups
+	assert.Equal(t, DecisionAccepted, decisions["o1"].Decision)
+	assert.Equal(t, 1, decisions["o1"].Rank)
+	assert.Equal(t, DecisionWaitlist, decisions["o2"].Decision)
+
+	// Groups without a quota accept nothing
+	assert.Equal(t, DecisionWaitlist, decisions["c1"].Decision)
+
+	// Without grouping the overall target applies
+	decisions = DecideGroupedProposals(proposals, nil, ConvergenceConfig{TargetAccepted: 2}, GroupConfig{})
+	assert.Equal(t, DecisionAccepted, decisions["c1"].Decision)
+	assert.Equal(t, DecisionAccepted, decisions["d1"].Decision)
+	assert.Equal(t, DecisionRejected, decisions["d2"].Decision)
+}
+
+func TestSessionProposalGroups(t *testing.T) {
+	proposals := createTestProposals()
+	proposals[0].Metadata = map[string]string{"track": "dev"}
+	session, err := NewSession("groups", proposals, createTestConfig(), "test.csv")
+	require.NoError(t, err)
+
+	assert.Equal(t, "", session.GetProposalGroups()["prop1"])
+
+	session.SetGroupConfig(GroupConfig{Column: "track"})
+	groups := session.GetProposalGroups()
+	assert.Equal(t, "dev", groups["prop1"])
+	assert.Equal(t, "", groups["prop2"])
+	assert.Equal(t, []string{"", "dev"}, GroupNames(session.Proposals, session.Config.Groups))
+}
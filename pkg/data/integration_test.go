@@ -505,7 +505,7 @@ func TestQuickstartScenarios(t *testing.T) {
 	})
 }
 
-// Contract Test T008: Integration test for complete ranking workflow without .jsonl files
+// Contract Test T008: Integration test for complete ranking workflow without .jsonl audit files
 func TestCompleteWorkflowNoJSONLIntegration(t *testing.T) {
 	t.Run("Complete_ranking_workflow_produces_no_jsonl_files", func(t *testing.T) {
 		tempDir := t.TempDir()
@@ -567,14 +567,14 @@ func TestCompleteWorkflowNoJSONLIntegration(t *testing.T) {
 		require.NoError(t, err)
 		assert.Contains(t, string(updatedContent), "score", "CSV should contain score column after export")
 
-		// CONTRACT: Complete workflow MUST NOT generate .jsonl files
-		// This is the critical test that must FAIL before journal removal and PASS after
+		// CONTRACT: Complete workflow MUST NOT generate .jsonl audit files
+		// The only line-delimited file allowed is the comparison history sidecar
+		sessionFile := filepath.Join(tempDir, SanitizeFilename(session.Name)+".json")
 		matches, err := filepath.Glob(filepath.Join(tempDir, "*.jsonl"))
 		require.NoError(t, err)
-		assert.Empty(t, matches, "CRITICAL: Complete workflow should not generate any .jsonl audit files after journal removal")
+		assert.Equal(t, []string{HistoryFilename(sessionFile)}, matches, "Only the comparison history log should be generated")
 
 		// Verify only expected files exist
-		sessionFile := filepath.Join(tempDir, SanitizeFilename(session.Name)+".json")
 		_, err = os.Stat(sessionFile)
 		assert.NoError(t, err, "Session JSON file should exist")
 
@@ -585,8 +585,7 @@ func TestCompleteWorkflowNoJSONLIntegration(t *testing.T) {
 		loadedSession, err := LoadSession(session.Name, tempDir)
 		require.NoError(t, err)
 		assert.Equal(t, session.Name, loadedSession.Name)
-		assert.Len(t, loadedSession.CompletedComparisons, 0) // Comparisons are not persisted		// CONTRACT: Loaded session should not have audit trail after journal removal
-		// Note: auditTrail field was successfully removed, so this contract is fulfilled
+		assert.Len(t, loadedSession.CompletedComparisons, len(comparisons)) // Comparisons are restored from the history log
 
 		t.Log("Integration test completed successfully")
 	})
@@ -0,0 +1,91 @@
+// Package data provides reviewer labels on proposals.
+// Unlike the conflict tags read from CSV, labels ("beginner-friendly", "needs co-speaker")
+// are applied by reviewers during review and kept in the session.
+package data
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ParseLabels splits a comma-separated label list into trimmed, non-empty labels,
+// dropping labels that repeat an earlier one in a different case
+func ParseLabels(list string) []string {
+	labels := make([]string, 0)
+	for _, label := range splitList(list) {
+		duplicate := false
+		for _, existing := range labels {
+			if strings.EqualFold(existing, label) {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			labels = append(labels, label)
+		}
+	}
+	return labels
+}
+
+// SetLabels replaces the reviewer labels of a proposal (no labels clears them)
+func (s *Session) SetLabels(proposalID string, labels []string) error {
+	labels = ParseLabels(strings.Join(labels, ","))
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	idx, exists := s.ProposalIndex[proposalID]
+	if !exists {
+		return fmt.Errorf("proposal not found: %s", proposalID)
+	}
+
+	if len(labels) == 0 {
+		delete(s.Labels, proposalID)
+		s.Proposals[idx].Labels = nil
+	} else {
+		if s.Labels == nil {
+			s.Labels = make(map[string][]string)
+		}
+		s.Labels[proposalID] = labels
+		s.Proposals[idx].Labels = append([]string(nil), labels...)
+	}
+
+	now := time.Now()
+	s.Proposals[idx].UpdatedAt = now
+	s.UpdatedAt = now
+	return nil
+}
+
+// GetLabels returns a copy of the reviewer labels of a proposal
+func (s *Session) GetLabels(proposalID string) []string {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return append([]string(nil), s.Labels[proposalID]...)
+}
+
+// GetAllLabels returns every label used in the session, sorted case-insensitively
+func (s *Session) GetAllLabels() []string {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	all := make([]string, 0)
+	for _, labels := range s.Labels {
+		all = append(all, labels...)
+	}
+	all = ParseLabels(strings.Join(all, ","))
+	sort.Slice(all, func(i, j int) bool {
+		return strings.ToLower(all[i]) < strings.ToLower(all[j])
+	})
+	return all
+}
+
+// restoreLabels applies the labels kept in the session to proposals reloaded from CSV
+func (s *Session) restoreLabels() {
+	for id, labels := range s.Labels {
+		if idx, exists := s.ProposalIndex[id]; exists {
+			s.Proposals[idx].Labels = append([]string(nil), labels...)
+		}
+	}
+}
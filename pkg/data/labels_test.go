@@ -0,0 +1,71 @@
+package data
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLabels(t *testing.T) {
+	assert.Equal(t, []string{"keynote", "beginner-friendly"}, ParseLabels(" keynote, beginner-friendly,,Keynote "))
+	assert.Empty(t, ParseLabels(" , "))
+}
+
+func TestSessionLabels(t *testing.T) {
+	t.Run("set, get and clear", func(t *testing.T) {
+		session, err := NewSession("Label Session", createTestProposals(), createTestConfig(), "test.csv")
+		require.NoError(t, err)
+
+		require.NoError(t, session.SetLabels("prop1", []string{" keynote ", "", "Keynote", "needs co-speaker"}))
+		assert.Equal(t, []string{"keynote", "needs co-speaker"}, session.GetLabels("prop1"))
+		assert.Empty(t, session.GetLabels("prop2"))
+
+		proposal, err := session.GetProposalByID("prop1")
+		require.NoError(t, err)
+		assert.True(t, proposal.HasLabel("KEYNOTE"))
+		assert.False(t, proposal.HasLabel("beginner-friendly"))
+
+		require.NoError(t, session.SetLabels("prop2", []string{"Beginner-Friendly", "keynote"}))
+		assert.Equal(t, []string{"Beginner-Friendly", "keynote", "needs co-speaker"}, session.GetAllLabels())
+
+		require.NoError(t, session.SetLabels("prop1", nil))
+		assert.Empty(t, session.GetLabels("prop1"))
+		proposal, err = session.GetProposalByID("prop1")
+		require.NoError(t, err)
+		assert.Nil(t, proposal.Labels)
+
+		assert.Error(t, session.SetLabels("missing", []string{"keynote"}))
+	})
+
+	t.Run("labels survive the CSV reload", func(t *testing.T) {
+		tempDir := t.TempDir()
+		csvPath := filepath.Join(tempDir, "proposals.csv")
+		require.NoError(t, os.WriteFile(csvPath, []byte(`id,title,speaker
+prop1,"Test Proposal 1","Speaker 1"
+prop2,"Test Proposal 2","Speaker 2"
+`), 0644))
+
+		storage := &FileStorage{}
+		result, err := storage.LoadProposalsFromCSVWithElo(csvPath, DefaultCSVConfig(), nil)
+		require.NoError(t, err)
+		session, err := NewSession("Label Session", result.Proposals, createTestConfig(), csvPath)
+		require.NoError(t, err)
+		require.NoError(t, session.SetLabels("prop2", []string{"keynote"}))
+
+		sessionFile := filepath.Join(tempDir, "labels.json")
+		require.NoError(t, storage.SaveSession(session, sessionFile))
+
+		loaded, err := storage.LoadSession(sessionFile)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"keynote"}, loaded.GetLabels("prop2"))
+		proposal, err := loaded.GetProposalByID("prop2")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"keynote"}, proposal.Labels)
+		proposal, err = loaded.GetProposalByID("prop1")
+		require.NoError(t, err)
+		assert.Empty(t, proposal.Labels)
+	})
+}
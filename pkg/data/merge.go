@@ -0,0 +1,254 @@
+// Package data provides merging of independent reviewer sessions.
+// Reviewers rank the same CSV offline in their own sessions; merging validates
+// that the sessions cover the same proposals, combines their comparison histories
+// into one chronological history, replays it into the consensus ratings and reports
+// where the reviewers disagree most.
+package data
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/pashagolub/confelo/pkg/elo"
+)
+
+// ErrMergeMismatch is returned when sessions cannot be merged into one consensus session
+var ErrMergeMismatch = errors.New("sessions cannot be merged")
+
+// ReviewerDisagreement describes how differently reviewer sessions ranked a proposal
+type ReviewerDisagreement struct {
+	ProposalID    string         // Proposal identifier
+	Title         string         // Proposal title for reporting
+	ConsensusRank int            // Rank in the merged session (1 = best)
+	Ranks         map[string]int // Rank in each source session keyed by session name
+	Spread        int            // Difference between the worst and the best source rank
+}
+
+// ValidateMergeSessions checks that at least two distinct sessions share the same proposal IDs
+func ValidateMergeSessions(sessions []*Session) error {
+	if len(sessions) < 2 {
+		return fmt.Errorf("%w: at least 2 sessions are required", ErrMergeMismatch)
+	}
+
+	names := make(map[string]bool, len(sessions))
+	for _, session := range sessions {
+		if session == nil {
+			return fmt.Errorf("%w: session is missing", ErrMergeMismatch)
+		}
+		if names[session.Name] {
+			return fmt.Errorf("%w: session %s listed more than once", ErrMergeMismatch, session.Name)
+		}
+		names[session.Name] = true
+	}
+
+	reference := sessions[0]
+	for _, session := range sessions[1:] {
+		if len(session.Proposals) != len(reference.Proposals) {
+			return fmt.Errorf("%w: session %s has %d proposals, session %s has %d",
+				ErrMergeMismatch, session.Name, len(session.Proposals), reference.Name, len(reference.Proposals))
+		}
+		for _, proposal := range session.Proposals {
+			if _, exists := reference.ProposalIndex[proposal.ID]; !exists {
+				return fmt.Errorf("%w: proposal %s of session %s is not in session %s",
+					ErrMergeMismatch, proposal.ID, session.Name, reference.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// NewMergedSession creates an empty consensus session for the given source sessions.
+// Proposals, configuration and input CSV are taken from the first session and every
+// proposal starts from its rating before the first comparison of that session.
+func NewMergedSession(name string, sessions []*Session) (*Session, error) {
+	if err := ValidateMergeSessions(sessions); err != nil {
+		return nil, err
+	}
+
+	reference := sessions[0]
+	startingRatings := reference.GetStartingRatings()
+
+	proposals := reference.GetProposals()
+	for i := range proposals {
+		proposals[i].Score = startingRatings[proposals[i].ID]
+		proposals[i].Deviation = 0
+		proposals[i].Volatility = 0
+	}
+
+	return NewSession(name, proposals, reference.Config, reference.InputCSVPath)
+}
+
+// MergeComparisons combines the completed comparisons of all sessions in chronological order.
+// Skipped comparisons are dropped, comparisons without a reviewer are attributed to their
+// source session and rating updates are cleared so they can be recalculated.
+func MergeComparisons(sessions []*Session) []Comparison {
+	merged := make([]Comparison, 0)
+	for _, session := range sessions {
+		for _, comparison := range session.GetComparisonHistory() {
+			if comparison.Skipped {
+				continue
+			}
+			if comparison.Reviewer == "" {
+				comparison.Reviewer = session.Name
+			}
+			comparison.SessionName = ""
+			comparison.EloUpdates = nil
+			merged = append(merged, comparison)
+		}
+	}
+
+	// Stable sort keeps the per-session order for identical timestamps
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].Timestamp.Before(merged[j].Timestamp)
+	})
+
+	return merged
+}
+
+// ReplayComparisons recalculates every comparison against the session's ratings with the
+// session's rating system and records it with the resulting rating updates; skipped
+// comparisons are ignored
+func (s *Session) ReplayComparisons(comparisons []Comparison) error {
+	eloConfig := s.Config.Elo
+	engine, err := elo.NewRatingEngine(elo.RatingSystem(eloConfig.RatingSystem), eloConfig.EngineConfig())
+	if err != nil {
+		return fmt.Errorf("failed to create rating engine: %w", err)
+	}
+
+	// Glicko-2 has no K-factor, its updates record 0
+	kFactor := 0
+	if eloEngine, ok := engine.(*elo.Engine); ok {
+		kFactor = eloEngine.KFactor
+	}
+
+	for _, comparison := range comparisons {
+		// Partial comparisons keep the proposals that were not picked or ranked unordered
+		outcomes := ComparisonOutcomes([]Comparison{comparison})
+		if len(outcomes) == 0 {
+			continue
+		}
+
+		ratings, err := s.currentRatings(outcomes[0].Ranking)
+		if err != nil {
+			return fmt.Errorf("comparison %s: %w", comparison.ID, err)
+		}
+
+		replayed, err := engine.Replay(ratings, outcomes)
+		if err != nil {
+			return fmt.Errorf("comparison %s: %w", comparison.ID, err)
+		}
+
+		comparison.EloUpdates = make([]EloUpdate, len(replayed))
+		for i, rating := range replayed {
+			comparison.EloUpdates[i] = EloUpdate{
+				ID:            fmt.Sprintf("upd_%s_%s", comparison.ID, rating.ID),
+				ComparisonID:  comparison.ID,
+				ProposalID:    rating.ID,
+				OldRating:     ratings[i].Score,
+				NewRating:     rating.Score,
+				RatingDelta:   rating.Score - ratings[i].Score,
+				KFactor:       kFactor,
+				OldDeviation:  ratings[i].Deviation,
+				NewDeviation:  rating.Deviation,
+				OldVolatility: ratings[i].Volatility,
+				NewVolatility: rating.Volatility,
+			}
+		}
+
+		if err := s.RecordComparison(comparison); err != nil {
+			return fmt.Errorf("comparison %s: %w", comparison.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// currentRatings returns the engine ratings of the given proposals, in the given order
+func (s *Session) currentRatings(ids []string) ([]elo.Rating, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	ratings := make([]elo.Rating, len(ids))
+	for i, id := range ids {
+		idx, exists := s.ProposalIndex[id]
+		if !exists {
+			return nil, fmt.Errorf("proposal not found: %s", id)
+		}
+		proposal := s.Proposals[idx]
+		ratings[i] = elo.Rating{
+			ID:         id,
+			Score:      proposal.Score,
+			Games:      s.ComparisonCounts[id],
+			Deviation:  proposal.Deviation,
+			Volatility: proposal.Volatility,
+		}
+	}
+	return ratings, nil
+}
+
+// FindDisagreements compares the rankings of the source sessions and returns up to limit
+// proposals with the largest rank spread, most disputed first (limit <= 0 returns all)
+func FindDisagreements(merged *Session, sessions []*Session, limit int) []ReviewerDisagreement {
+	consensusRanks := rankByScore(merged.GetProposals())
+
+	sessionRanks := make(map[string]map[string]int, len(sessions))
+	for _, session := range sessions {
+		sessionRanks[session.Name] = rankByScore(session.GetProposals())
+	}
+
+	disagreements := make([]ReviewerDisagreement, 0, len(consensusRanks))
+	for _, proposal := range merged.GetProposals() {
+		disagreement := ReviewerDisagreement{
+			ProposalID:    proposal.ID,
+			Title:         proposal.Title,
+			ConsensusRank: consensusRanks[proposal.ID],
+			Ranks:         make(map[string]int, len(sessions)),
+		}
+
+		best, worst := 0, 0
+		for name, ranks := range sessionRanks {
+			rank := ranks[proposal.ID]
+			disagreement.Ranks[name] = rank
+			if best == 0 || rank < best {
+				best = rank
+			}
+			if rank > worst {
+				worst = rank
+			}
+		}
+		disagreement.Spread = worst - best
+
+		disagreements = append(disagreements, disagreement)
+	}
+
+	sort.Slice(disagreements, func(i, j int) bool {
+		if disagreements[i].Spread != disagreements[j].Spread {
+			return disagreements[i].Spread > disagreements[j].Spread
+		}
+		return disagreements[i].ConsensusRank < disagreements[j].ConsensusRank
+	})
+
+	if limit > 0 && len(disagreements) > limit {
+		disagreements = disagreements[:limit]
+	}
+
+	return disagreements
+}
+
+// rankByScore returns the 1-based rank of each proposal, highest score first (ties broken by ID)
+func rankByScore(proposals []Proposal) map[string]int {
+	sort.Slice(proposals, func(i, j int) bool {
+		if proposals[i].Score != proposals[j].Score {
+			return proposals[i].Score > proposals[j].Score
+		}
+		return proposals[i].ID < proposals[j].ID
+	})
+
+	ranks := make(map[string]int, len(proposals))
+	for i, proposal := range proposals {
+		ranks[proposal.ID] = i + 1
+	}
+	return ranks
+}
@@ -0,0 +1,222 @@
+package data
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pashagolub/confelo/pkg/elo"
+)
+
+func TestMergeSessions(t *testing.T) {
+	newReviewerSession := func(t *testing.T, name string) *Session {
+		session, err := NewSession(name, createTestProposals(), createTestConfig(), "test.csv")
+		require.NoError(t, err)
+		return session
+	}
+
+	record := func(t *testing.T, session *Session, id, winner, loser string, at time.Time) {
+		winnerProposal, err := session.GetProposalByID(winner)
+		require.NoError(t, err)
+		loserProposal, err := session.GetProposalByID(loser)
+		require.NoError(t, err)
+
+		comparison := createUndoTestComparison(id, winner, loser, winnerProposal.Score, loserProposal.Score)
+		comparison.Timestamp = at
+		require.NoError(t, session.RecordComparison(comparison))
+	}
+
+	start := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("validates sessions", func(t *testing.T) {
+		alice := newReviewerSession(t, "alice")
+
+		err := ValidateMergeSessions([]*Session{alice})
+		assert.ErrorIs(t, err, ErrMergeMismatch)
+
+		err = ValidateMergeSessions([]*Session{alice, alice})
+		assert.ErrorIs(t, err, ErrMergeMismatch)
+
+		proposals := createTestProposals()
+		proposals[2].ID = "other"
+		bob, err := NewSession("bob", proposals, createTestConfig(), "test.csv")
+		require.NoError(t, err)
+		err = ValidateMergeSessions([]*Session{alice, bob})
+		assert.ErrorIs(t, err, ErrMergeMismatch)
+		assert.Contains(t, err.Error(), "other")
+
+		carol, err := NewSession("carol", createTestProposals()[:2], createTestConfig(), "test.csv")
+		require.NoError(t, err)
+		assert.ErrorIs(t, ValidateMergeSessions([]*Session{alice, carol}), ErrMergeMismatch)
+
+		assert.NoError(t, ValidateMergeSessions([]*Session{alice, newReviewerSession(t, "dave")}))
+	})
+
+	t.Run("merged session starts from starting ratings", func(t *testing.T) {
+		alice := newReviewerSession(t, "alice")
+		bob := newReviewerSession(t, "bob")
+		record(t, alice, "a1", "prop1", "prop2", start)
+
+		merged, err := NewMergedSession("consensus", []*Session{alice, bob})
+		require.NoError(t, err)
+		assert.Equal(t, "consensus", merged.Name)
+		assert.Equal(t, 0, merged.TotalComparisons)
+		for _, proposal := range merged.GetProposals() {
+			assert.Equal(t, 1500.0, proposal.Score)
+		}
+
+		// Source sessions are not modified
+		proposal, err := alice.GetProposalByID("prop1")
+		require.NoError(t, err)
+		assert.Equal(t, 1516.0, proposal.Score)
+	})
+
+	t.Run("combines comparisons chronologically", func(t *testing.T) {
+		alice := newReviewerSession(t, "alice")
+		bob := newReviewerSession(t, "bob")
+		bob.SetReviewer("Bob Smith")
+
+		record(t, alice, "a1", "prop1", "prop2", start)
+		record(t, alice, "a2", "prop1", "prop3", start.Add(2*time.Minute))
+		record(t, bob, "b1", "prop2", "prop1", start.Add(time.Minute))
+		require.NoError(t, bob.RecordComparison(Comparison{
+			ID:          "b2",
+			ProposalIDs: []string{"prop2", "prop3"},
+			Method:      MethodPairwise,
+			Skipped:     true,
+			Timestamp:   start.Add(3 * time.Minute),
+		}))
+
+		comparisons := MergeComparisons([]*Session{alice, bob})
+		require.Len(t, comparisons, 3)
+
+		ids := []string{comparisons[0].ID, comparisons[1].ID, comparisons[2].ID}
+		assert.Equal(t, []string{"a1", "b1", "a2"}, ids)
+		assert.Equal(t, "alice", comparisons[0].Reviewer)
+		assert.Equal(t, "Bob Smith", comparisons[1].Reviewer)
+		for _, comparison := range comparisons {
+			assert.Empty(t, comparison.EloUpdates)
+			assert.Empty(t, comparison.SessionName)
+		}
+	})
+
+	t.Run("reports largest disagreements first", func(t *testing.T) {
+		alice := newReviewerSession(t, "alice")
+		bob := newReviewerSession(t, "bob")
+
+		// Alice ranks prop1 > prop2 > prop3, Bob ranks prop3 > prop2 > prop1
+		record(t, alice, "a1", "prop1", "prop2", start)
+		record(t, alice, "a2", "prop2", "prop3", start)
+		record(t, bob, "b1", "prop3", "prop2", start)
+		record(t, bob, "b2", "prop2", "prop1", start)
+
+		merged, err := NewMergedSession("consensus", []*Session{alice, bob})
+		require.NoError(t, err)
+
+		disagreements := FindDisagreements(merged, []*Session{alice, bob}, 2)
+		require.Len(t, disagreements, 2)
+
+		assert.Equal(t, 2, disagreements[0].Spread)
+		assert.Equal(t, 2, disagreements[1].Spread)
+		assert.ElementsMatch(t, []string{"prop1", "prop3"},
+			[]string{disagreements[0].ProposalID, disagreements[1].ProposalID})
+
+		ranks := disagreements[0].Ranks
+		if disagreements[0].ProposalID == "prop1" {
+			assert.Equal(t, map[string]int{"alice": 1, "bob": 3}, ranks)
+		} else {
+			assert.Equal(t, map[string]int{"alice": 3, "bob": 1}, ranks)
+		}
+
+		all := FindDisagreements(merged, []*Session{alice, bob}, 0)
+		require.Len(t, all, 3)
+		assert.Equal(t, "prop2", all[2].ProposalID)
+		assert.Equal(t, 0, all[2].Spread)
+	})
+	t.Run("replayed ratings match the source session", func(t *testing.T) {
+		alice := newReviewerSession(t, "alice")
+		bob := newReviewerSession(t, "bob")
+
+		rateInSession(t, alice, Comparison{ID: "a1", ProposalIDs: []string{"prop1", "prop2"}, WinnerID: "prop1",
+			Method: MethodPairwise, Timestamp: start})
+		rateInSession(t, alice, Comparison{ID: "a2", ProposalIDs: []string{"prop1", "prop2", "prop3"}, WinnerID: "prop3",
+			Rankings: []string{"prop3", "prop1", "prop2"}, Method: MethodTrio, Timestamp: start.Add(time.Minute)})
+		rateInSession(t, alice, Comparison{ID: "a3", ProposalIDs: []string{"prop2", "prop3"}, WinnerID: "prop2",
+			Method: MethodPairwise, Timestamp: start.Add(2 * time.Minute)})
+
+		// Pick-best leaves the proposals that were not picked unordered
+		rateInSession(t, alice, Comparison{ID: "a4", ProposalIDs: []string{"prop1", "prop2", "prop3"}, WinnerID: "prop2",
+			Rankings: []string{"prop2", "prop1", "prop3"}, Selected: 1, Method: MethodPickBest, Timestamp: start.Add(3 * time.Minute)})
+		require.True(t, alice.GetComparisonHistory()[3].IsPartial())
+
+		merged, err := NewMergedSession("consensus", []*Session{alice, bob})
+		require.NoError(t, err)
+		require.NoError(t, merged.ReplayComparisons(MergeComparisons([]*Session{alice, bob})))
+
+		assert.Equal(t, 4, merged.TotalComparisons)
+		for _, proposal := range alice.GetProposals() {
+			replayed, err := merged.GetProposalByID(proposal.ID)
+			require.NoError(t, err)
+			assert.InDelta(t, proposal.Score, replayed.Score, 1e-9, proposal.ID)
+			assert.Equal(t, alice.ComparisonCounts[proposal.ID], merged.ComparisonCounts[proposal.ID], proposal.ID)
+		}
+
+		history := merged.GetComparisonHistory()
+		require.Len(t, history, 4)
+		require.Len(t, history[1].EloUpdates, 3)
+		assert.Equal(t, createTestConfig().Elo.KFactor, history[1].EloUpdates[0].KFactor)
+	})
+
+	t.Run("replay rejects unknown proposals", func(t *testing.T) {
+		merged := newReviewerSession(t, "consensus")
+		err := merged.ReplayComparisons([]Comparison{
+			{ID: "x1", ProposalIDs: []string{"prop1", "other"}, WinnerID: "prop1", Method: MethodPairwise},
+		})
+		assert.ErrorContains(t, err, "x1")
+		assert.Equal(t, 0, merged.TotalComparisons)
+	})
+}
+
+// rateInSession rates a comparison with the session's Elo engine the way the comparison
+// screen does and records it with the resulting rating updates
+func rateInSession(t *testing.T, session *Session, comparison Comparison) {
+	t.Helper()
+
+	engine, err := elo.NewEngine(session.Config.Elo.EngineConfig())
+	require.NoError(t, err)
+
+	ranked := make([]elo.Rating, 0, len(comparison.ProposalIDs))
+	for _, id := range comparison.Outcome() {
+		proposal, err := session.GetProposalByID(id)
+		require.NoError(t, err)
+		ranked = append(ranked, elo.Rating{ID: id, Score: proposal.Score, Games: session.ComparisonCounts[id]})
+	}
+
+	var updated []elo.Rating
+	switch {
+	case comparison.IsPartial():
+		updated, _, err = engine.CalculatePartial(ranked, comparison.OutcomePositions())
+	case len(ranked) == 2:
+		var winner, loser elo.Rating
+		winner, loser, err = engine.CalculatePairwise(ranked[0], ranked[1])
+		updated = []elo.Rating{winner, loser}
+	default:
+		updated, _, err = engine.CalculateMultiway(ranked)
+	}
+	require.NoError(t, err)
+
+	for i, rating := range updated {
+		comparison.EloUpdates = append(comparison.EloUpdates, EloUpdate{
+			ID:           comparison.ID + "_" + rating.ID,
+			ComparisonID: comparison.ID,
+			ProposalID:   rating.ID,
+			OldRating:    ranked[i].Score,
+			NewRating:    rating.Score,
+			RatingDelta:  rating.Score - ranked[i].Score,
+			KFactor:      engine.KFactor,
+		})
+	}
+	require.NoError(t, session.RecordComparison(comparison))
+}
@@ -0,0 +1,72 @@
+// Package data provides reviewer notes on proposals and comparisons.
+// A proposal note starts from the CSV comment column and is written back to it on export;
+// a comparison note records why the reviewer decided the way they did.
+package data
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// SetNote replaces the reviewer note of a proposal (an empty note clears it).
+// The note is kept in the session so it survives reloading proposals from CSV.
+func (s *Session) SetNote(proposalID, note string) error {
+	note = strings.TrimSpace(note)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	idx, exists := s.ProposalIndex[proposalID]
+	if !exists {
+		return fmt.Errorf("proposal not found: %s", proposalID)
+	}
+
+	if s.Notes == nil {
+		s.Notes = make(map[string]string)
+	}
+	s.Notes[proposalID] = note
+	s.Proposals[idx].Comment = note
+
+	now := time.Now()
+	s.Proposals[idx].UpdatedAt = now
+	s.UpdatedAt = now
+	return nil
+}
+
+// GetNote returns the reviewer note of a proposal (empty when it has none)
+func (s *Session) GetNote(proposalID string) string {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	if idx, exists := s.ProposalIndex[proposalID]; exists {
+		return s.Proposals[idx].Comment
+	}
+	return ""
+}
+
+// restoreNotes applies the notes edited in the session to proposals reloaded from CSV
+func (s *Session) restoreNotes() {
+	for id, note := range s.Notes {
+		if idx, exists := s.ProposalIndex[id]; exists {
+			s.Proposals[idx].Comment = note
+		}
+	}
+}
+
+// SetComparisonNote records why a completed comparison was decided the way it was
+// (an empty note clears it). The history log is rewritten on the next save.
+func (s *Session) SetComparisonNote(comparisonID, note string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for i := range s.CompletedComparisons {
+		if s.CompletedComparisons[i].ID == comparisonID {
+			s.CompletedComparisons[i].Note = strings.TrimSpace(note)
+			s.history = historyCursor{}
+			s.UpdatedAt = time.Now()
+			return nil
+		}
+	}
+	return fmt.Errorf("comparison not found: %s", comparisonID)
+}
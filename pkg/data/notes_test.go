@@ -0,0 +1,137 @@
+package data
+
+import (
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionNotes(t *testing.T) {
+	newNoteSession := func(t *testing.T) *Session {
+		session, err := NewSession("Note Session", createTestProposals(), createTestConfig(), "test.csv")
+		require.NoError(t, err)
+		return session
+	}
+
+	t.Run("proposal notes", func(t *testing.T) {
+		session := newNoteSession(t)
+
+		require.NoError(t, session.SetNote("prop1", "  Great demo, weak abstract  "))
+		assert.Equal(t, "Great demo, weak abstract", session.GetNote("prop1"))
+		assert.Equal(t, "", session.GetNote("prop2"))
+
+		proposal, err := session.GetProposalByID("prop1")
+		require.NoError(t, err)
+		assert.Equal(t, "Great demo, weak abstract", proposal.Comment)
+
+		require.NoError(t, session.SetNote("prop1", ""))
+		assert.Equal(t, "", session.GetNote("prop1"))
+		assert.Error(t, session.SetNote("missing", "note"))
+	})
+
+	t.Run("comparison notes", func(t *testing.T) {
+		session := newNoteSession(t)
+		require.NoError(t, session.RecordComparison(createUndoTestComparison("c1", "prop1", "prop2", 1500, 1500)))
+
+		require.NoError(t, session.SetComparisonNote("c1", " Clearer takeaway "))
+		history := session.GetComparisonHistory()
+		require.Len(t, history, 1)
+		assert.Equal(t, "Clearer takeaway", history[0].Note)
+
+		results := session.GetProposalResults("prop2")
+		require.Len(t, results, 1)
+		assert.Equal(t, "Clearer takeaway", results[0].Note)
+
+		assert.Error(t, session.SetComparisonNote("missing", "note"))
+	})
+
+	t.Run("notes are persisted with the session", func(t *testing.T) {
+		tempDir := t.TempDir()
+		csvPath := filepath.Join(tempDir, "proposals.csv")
+		require.NoError(t, os.WriteFile(csvPath, []byte(`id,title,speaker,comments
+prop1,"Test Proposal 1","Speaker 1","From the CSV"
+prop2,"Test Proposal 2","Speaker 2","Cleared in the session"
+prop3,"Test Proposal 3","Speaker 3",""
+`), 0644))
+
+		storage := &FileStorage{}
+		result, err := storage.LoadProposalsFromCSVWithElo(csvPath, DefaultCSVConfig(), nil)
+		require.NoError(t, err)
+		session, err := NewSession("Note Session", result.Proposals, createTestConfig(), csvPath)
+		require.NoError(t, err)
+		assert.Equal(t, "From the CSV", session.GetNote("prop1"))
+
+		require.NoError(t, session.SetNote("prop2", ""))
+		require.NoError(t, session.SetNote("prop3", "Edited in the session"))
+		require.NoError(t, session.RecordComparison(createUndoTestComparison("c1", "prop1", "prop2", 1500, 1500)))
+
+		sessionFile := filepath.Join(tempDir, "notes.json")
+		require.NoError(t, storage.SaveSession(session, sessionFile))
+
+		// Editing a saved comparison's note rewrites the history log
+		require.NoError(t, session.SetComparisonNote("c1", "Better fit for the audience"))
+		require.NoError(t, storage.SaveSession(session, sessionFile))
+
+		loaded, err := storage.LoadSession(sessionFile)
+		require.NoError(t, err)
+		assert.Equal(t, "From the CSV", loaded.GetNote("prop1"))
+		assert.Equal(t, "", loaded.GetNote("prop2"))
+		assert.Equal(t, "Edited in the session", loaded.GetNote("prop3"))
+		require.Len(t, loaded.CompletedComparisons, 1)
+		assert.Equal(t, "Better fit for the audience", loaded.CompletedComparisons[0].Note)
+	})
+}
+
+func TestFileStorage_UpdateCSVNotes(t *testing.T) {
+	readCSV := func(t *testing.T, path string) [][]string {
+		file, err := os.Open(path)
+		require.NoError(t, err)
+		defer func() { _ = file.Close() }()
+		records, err := csv.NewReader(file).ReadAll()
+		require.NoError(t, err)
+		return records
+	}
+
+	proposals := []Proposal{
+		{ID: "prop1", Score: 1500, Comment: "Keep"},
+		{ID: "prop2", Score: 1500},
+	}
+
+	t.Run("writes the comment column", func(t *testing.T) {
+		csvPath := filepath.Join(t.TempDir(), "proposals.csv")
+		require.NoError(t, os.WriteFile(csvPath, []byte("id,title,score,comments\nprop1,One,0,\nprop2,Two,0,Old\nprop3,Three,0,Untouched\n"), 0644))
+
+		require.NoError(t, NewFileStorage().UpdateCSVScores(proposals, csvPath, DefaultCSVConfig(), nil))
+
+		records := readCSV(t, csvPath)
+		assert.Equal(t, []string{"id", "title", "score", "comments"}, records[0])
+		assert.Equal(t, "Keep", records[1][3])
+		assert.Equal(t, "", records[2][3])
+		assert.Equal(t, "Untouched", records[3][3])
+	})
+
+	t.Run("adds a missing comment column", func(t *testing.T) {
+		csvPath := filepath.Join(t.TempDir(), "proposals.csv")
+		require.NoError(t, os.WriteFile(csvPath, []byte("id,title,score\nprop1,One,0\nprop2,Two,0\n"), 0644))
+
+		require.NoError(t, NewFileStorage().UpdateCSVScores(proposals, csvPath, DefaultCSVConfig(), nil))
+
+		records := readCSV(t, csvPath)
+		assert.Equal(t, []string{"id", "title", "score", "comments"}, records[0])
+		assert.Equal(t, []string{"prop1", "One", "1500.0", "Keep"}, records[1])
+		assert.Equal(t, []string{"prop2", "Two", "1500.0", ""}, records[2])
+	})
+
+	t.Run("leaves CSV without notes alone", func(t *testing.T) {
+		csvPath := filepath.Join(t.TempDir(), "proposals.csv")
+		require.NoError(t, os.WriteFile(csvPath, []byte("id,title,score\nprop2,Two,0\n"), 0644))
+
+		require.NoError(t, NewFileStorage().UpdateCSVScores(proposals[1:], csvPath, DefaultCSVConfig(), nil))
+
+		assert.Equal(t, []string{"id", "title", "score"}, readCSV(t, csvPath)[0])
+	})
+}
@@ -31,8 +31,12 @@ type Proposal struct {
 	Speaker       string            `json:"speaker,omitempty"`        // Presenter information (optional)
 	Score         float64           `json:"score"`                    // Current Elo rating
 	OriginalScore *float64          `json:"original_score,omitempty"` // Initial rating from CSV (optional)
+	Deviation     float64           `json:"deviation,omitempty"`      // Rating deviation (Glicko-2 only)
+	Volatility    float64           `json:"volatility,omitempty"`     // Rating volatility (Glicko-2 only)
 	Metadata      map[string]string `json:"metadata,omitempty"`       // Additional CSV columns
 	ConflictTags  []string          `json:"conflict_tags,omitempty"`  // Conflict-of-interest identifiers
+	Comment       string            `json:"comment,omitempty"`        // Reviewer note (CSV comment column)
+	Labels        []string          `json:"labels,omitempty"`         // Reviewer labels applied during review
 	CreatedAt     time.Time         `json:"created_at"`               // When proposal was loaded
 	UpdatedAt     time.Time         `json:"updated_at"`               // Last modification time
 }
@@ -209,6 +213,17 @@ func (p *Proposal) HasConflictTag(tag string) bool {
 	return false
 }
 
+// HasLabel checks if the proposal carries a reviewer label (case-insensitive)
+func (p *Proposal) HasLabel(label string) bool {
+	label = strings.TrimSpace(label)
+	for _, existing := range p.Labels {
+		if strings.EqualFold(existing, label) {
+			return true
+		}
+	}
+	return false
+}
+
 // SetMetadata sets a metadata key-value pair
 func (p *Proposal) SetMetadata(key, value string) {
 	if p.Metadata == nil {
@@ -0,0 +1,119 @@
+// Package data provides the comparison results of a single proposal.
+// Each decided comparison is seen from the proposal's side: whom it was preferred over,
+// who was preferred over it and how its rating moved, for the rankings detail view.
+package data
+
+import (
+	"time"
+)
+
+// ProposalResult is one decided comparison seen from a single proposal
+type ProposalResult struct {
+	ComparisonID string           // Comparison the result comes from
+	Reviewer     string           // Reviewer who made the comparison (optional)
+	Method       ComparisonMethod // Comparison type
+	Timestamp    time.Time        // When the comparison was completed
+	Won          []string         // Proposals it was preferred over
+	Lost         []string         // Proposals preferred over it
+	Drawn        []string         // Proposals judged equally good
+	OldRating    float64          // Rating before the comparison
+	NewRating    float64          // Rating after the comparison
+	Note         string           // Why the reviewer decided this way (optional)
+}
+
+// GetProposalResults returns the decided comparisons a proposal took part in, oldest first.
+// Multi-proposal comparisons without full rankings only tell who was best, so the other
+// proposals count as beaten by the winner and as undecided among themselves. Pick-best and
+// rank-top comparisons leave the proposals that share a position undecided in the same way.
+func (s *Session) GetProposalResults(proposalID string) []ProposalResult {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	results := make([]ProposalResult, 0)
+	for _, comparison := range s.CompletedComparisons {
+		outcome := comparison.Outcome()
+		if outcome == nil || indexOf(comparison.ProposalIDs, proposalID) < 0 {
+			continue
+		}
+
+		result := ProposalResult{
+			ComparisonID: comparison.ID,
+			Reviewer:     comparison.Reviewer,
+			Method:       comparison.Method,
+			Timestamp:    comparison.Timestamp,
+			Note:         comparison.Note,
+		}
+
+		switch {
+		case comparison.Draw:
+			result.Drawn = otherIDs(comparison.ProposalIDs, proposalID)
+		case comparison.IsPartial():
+			positions := comparison.OutcomePositions()
+			own := positions[indexOf(outcome, proposalID)]
+			for i, id := range outcome {
+				switch {
+				case positions[i] < own:
+					result.Lost = append(result.Lost, id)
+				case positions[i] > own:
+					result.Won = append(result.Won, id)
+				}
+			}
+		case len(comparison.Rankings) == len(comparison.ProposalIDs):
+			// Full rankings order every pair of proposals
+			position := indexOf(outcome, proposalID)
+			result.Lost = append(result.Lost, outcome[:position]...)
+			result.Won = append(result.Won, outcome[position+1:]...)
+		case comparison.WinnerID == proposalID:
+			result.Won = otherIDs(comparison.ProposalIDs, proposalID)
+		default:
+			result.Lost = []string{comparison.WinnerID}
+		}
+
+		for _, update := range comparison.EloUpdates {
+			if update.ProposalID == proposalID {
+				result.OldRating = update.OldRating
+				result.NewRating = update.NewRating
+				break
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// RatingHistory returns the rating before the first result followed by the rating after each result
+func RatingHistory(results []ProposalResult) []float64 {
+	if len(results) == 0 {
+		return nil
+	}
+
+	history := make([]float64, 0, len(results)+1)
+	history = append(history, results[0].OldRating)
+	for _, result := range results {
+		history = append(history, result.NewRating)
+	}
+	return history
+}
+
+// indexOf returns the position of an ID in the list, or -1 when it is missing
+func indexOf(ids []string, id string) int {
+	for i, candidate := range ids {
+		if candidate == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// otherIDs returns the IDs of the list except the given one
+func otherIDs(ids []string, id string) []string {
+	others := make([]string, 0, len(ids))
+	for _, candidate := range ids {
+		if candidate != id {
+			others = append(others, candidate)
+		}
+	}
+	return others
+}
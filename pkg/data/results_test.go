@@ -0,0 +1,100 @@
+package data
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionProposalResults(t *testing.T) {
+	session, err := NewSession("Results Session", createTestProposals(), createTestConfig(), "test.csv")
+	require.NoError(t, err)
+
+	require.NoError(t, session.RecordComparison(createUndoTestComparison("c1", "prop1", "prop2", 1500, 1500)))
+	require.NoError(t, session.RecordComparison(createUndoTestComparison("c2", "prop3", "prop1", 1500, 1516)))
+	require.NoError(t, session.RecordComparison(Comparison{
+		ID:          "c3",
+		ProposalIDs: []string{"prop1", "prop2", "prop3"},
+		WinnerID:    "prop3",
+		Rankings:    []string{"prop3", "prop1", "prop2"},
+		Method:      MethodTrio,
+	}))
+	require.NoError(t, session.RecordComparison(Comparison{
+		ID:          "c4",
+		ProposalIDs: []string{"prop2", "prop1", "prop3"},
+		WinnerID:    "prop2",
+		Method:      MethodTrio,
+	}))
+	require.NoError(t, session.RecordComparison(Comparison{ID: "c5", ProposalIDs: []string{"prop1", "prop2"}, Draw: true, Method: MethodPairwise}))
+	require.NoError(t, session.RecordComparison(Comparison{ID: "c6", ProposalIDs: []string{"prop1", "prop3"}, Skipped: true, Method: MethodPairwise}))
+
+	results := session.GetProposalResults("prop1")
+	require.Len(t, results, 5, "skipped comparisons have no result")
+
+	assert.Equal(t, []string{"prop2"}, results[0].Won)
+	assert.Empty(t, results[0].Lost)
+	assert.Equal(t, 1500.0, results[0].OldRating)
+	assert.Equal(t, 1516.0, results[0].NewRating)
+
+	assert.Equal(t, []string{"prop3"}, results[1].Lost)
+	assert.Equal(t, 1500.0, results[1].NewRating)
+
+	// Full rankings order every pair
+	assert.Equal(t, []string{"prop2"}, results[2].Won)
+	assert.Equal(t, []string{"prop3"}, results[2].Lost)
+
+	// Without full rankings only the loss to the winner is known
+	assert.Empty(t, results[3].Won)
+	assert.Equal(t, []string{"prop2"}, results[3].Lost)
+
+	assert.Equal(t, []string{"prop2"}, results[4].Drawn)
+
+	winner := session.GetProposalResults("prop2")
+	assert.Equal(t, []string{"prop1", "prop3"}, winner[2].Won)
+
+	assert.Empty(t, session.GetProposalResults("prop4"))
+}
+
+func TestSessionPartialResults(t *testing.T) {
+	proposals := append(createTestProposals(), Proposal{ID: "prop4", Title: "Fourth Talk", Speaker: "Dana", Score: 1500})
+	session, err := NewSession("Partial Results", proposals, createTestConfig(), "test.csv")
+	require.NoError(t, err)
+
+	ids := []string{"prop1", "prop2", "prop3", "prop4"}
+	require.NoError(t, session.RecordComparison(Comparison{
+		ID: "c1", ProposalIDs: ids, WinnerID: "prop3", Rankings: []string{"prop3", "prop1", "prop2", "prop4"},
+		Selected: 2, Method: MethodPickBest,
+	}))
+	require.NoError(t, session.RecordComparison(Comparison{
+		ID: "c2", ProposalIDs: ids, WinnerID: "prop2", Rankings: []string{"prop2", "prop1", "prop3", "prop4"},
+		Selected: 1, Method: MethodRankTop,
+	}))
+
+	picked := session.GetProposalResults("prop1")
+	require.Len(t, picked, 2)
+	assert.Equal(t, []string{"prop2", "prop4"}, picked[0].Won, "picked proposals are not ordered against each other")
+	assert.Empty(t, picked[0].Lost)
+	assert.Equal(t, []string{"prop2"}, picked[1].Lost)
+	assert.Empty(t, picked[1].Won, "unranked proposals are not ordered against each other")
+
+	stats := comparisonStats(session.GetComparisonHistory())
+	assert.Equal(t, 1, stats["prop1"].Wins)
+	assert.Equal(t, 1, stats["prop3"].Wins)
+	assert.Equal(t, 2, stats["prop4"].Losses)
+
+	outcomes := ComparisonOutcomes(session.GetComparisonHistory())
+	assert.True(t, outcomes[0].Unordered)
+	assert.Equal(t, []int{0, 0, 2, 2}, outcomes[0].Positions)
+}
+
+func TestRatingHistory(t *testing.T) {
+	assert.Nil(t, RatingHistory(nil))
+
+	history := RatingHistory([]ProposalResult{
+		{OldRating: 1500, NewRating: 1516},
+		{OldRating: 1516, NewRating: 1500},
+		{OldRating: 1500, NewRating: 1520},
+	})
+	assert.Equal(t, []float64{1500, 1516, 1500, 1520}, history)
+}
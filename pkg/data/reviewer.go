@@ -0,0 +1,160 @@
+// Package data provides reviewer attribution for comparisons.
+// A program committee shares one session; every comparison records the reviewer
+// who made it so pooled and per-reviewer rankings can be derived from the history.
+package data
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/pashagolub/confelo/pkg/elo"
+)
+
+// SetReviewer sets the reviewer attributed to comparisons recorded from now on.
+// An empty name records comparisons without attribution.
+func (s *Session) SetReviewer(reviewer string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.reviewer = strings.TrimSpace(reviewer)
+}
+
+// GetReviewer returns the reviewer attributed to new comparisons
+func (s *Session) GetReviewer() string {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.reviewer
+}
+
+// GetReviewers returns the names of all reviewers who made comparisons, sorted alphabetically
+func (s *Session) GetReviewers() []string {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	seen := make(map[string]bool)
+	for reviewer := range s.ReviewerCounts {
+		seen[reviewer] = true
+	}
+	for _, comparison := range s.CompletedComparisons {
+		if comparison.Reviewer != "" {
+			seen[comparison.Reviewer] = true
+		}
+	}
+
+	reviewers := make([]string, 0, len(seen))
+	for reviewer := range seen {
+		reviewers = append(reviewers, reviewer)
+	}
+	sort.Strings(reviewers)
+	return reviewers
+}
+
+// GetReviewerComparisonCount returns how many comparisons a reviewer has made
+func (s *Session) GetReviewerComparisonCount(reviewer string) int {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.ReviewerCounts[reviewer]
+}
+
+// GetReviewerComparisons returns the completed comparisons made by a reviewer (thread-safe copy)
+func (s *Session) GetReviewerComparisons(reviewer string) []Comparison {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	comparisons := make([]Comparison, 0)
+	for _, comparison := range s.CompletedComparisons {
+		if comparison.Reviewer == reviewer {
+			comparisons = append(comparisons, comparison)
+		}
+	}
+	return comparisons
+}
+
+// GetStartingRatings returns each proposal's rating before its first recorded comparison.
+// Proposals that were never compared report their current rating.
+func (s *Session) GetStartingRatings() map[string]float64 {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	ratings := make(map[string]float64, len(s.Proposals))
+	for _, proposal := range s.Proposals {
+		ratings[proposal.ID] = proposal.Score
+	}
+
+	// Walk the history backwards so the earliest update of each proposal wins
+	for i := len(s.CompletedComparisons) - 1; i >= 0; i-- {
+		updates := s.CompletedComparisons[i].EloUpdates
+		for j := len(updates) - 1; j >= 0; j-- {
+			if _, exists := ratings[updates[j].ProposalID]; exists {
+				ratings[updates[j].ProposalID] = updates[j].OldRating
+			}
+		}
+	}
+
+	return ratings
+}
+
+// Outcome returns the compared proposals ordered from best to worst,
+// or nil when the comparison was skipped or has no winner.
+// Drawn proposals are returned in presented order (see OutcomePositions).
+func (c Comparison) Outcome() []string {
+	if c.Skipped {
+		return nil
+	}
+
+	if c.Draw {
+		outcome := make([]string, len(c.ProposalIDs))
+		copy(outcome, c.ProposalIDs)
+		return outcome
+	}
+
+	if c.WinnerID == "" {
+		return nil
+	}
+
+	if len(c.Rankings) == len(c.ProposalIDs) {
+		outcome := make([]string, len(c.Rankings))
+		copy(outcome, c.Rankings)
+		return outcome
+	}
+
+	// Without full rankings only the winner is known; keep the others in presented order
+	outcome := make([]string, 0, len(c.ProposalIDs))
+	outcome = append(outcome, c.WinnerID)
+	for _, id := range c.ProposalIDs {
+		if id != c.WinnerID {
+			outcome = append(outcome, id)
+		}
+	}
+	return outcome
+}
+
+// OutcomePositions returns the 0-based finishing position of each proposal in Outcome,
+// all 0 for a draw; nil means every proposal has its own position.
+// In a partial comparison the proposals left unordered share a position.
+func (c Comparison) OutcomePositions() []int {
+	switch {
+	case c.Skipped:
+		return nil
+	case c.Draw:
+		return make([]int, len(c.ProposalIDs))
+	case c.IsPartial() && c.Method == MethodPickBest:
+		return elo.PickPositions(len(c.ProposalIDs), c.Selected)
+	case c.IsPartial():
+		return elo.TopPositions(len(c.ProposalIDs), c.Selected)
+	default:
+		return nil
+	}
+}
+
+// IsPartial reports whether only the first Selected proposals of the rankings were
+// picked or ranked, leaving the others unordered
+func (c Comparison) IsPartial() bool {
+	return c.Method.IsPartial() && !c.Skipped && !c.Draw &&
+		len(c.Rankings) == len(c.ProposalIDs) && c.Selected > 0 && c.Selected < len(c.ProposalIDs)
+}
+
+// isSelected reports whether a proposal was among the picked or ranked ones of a partial comparison
+func (c Comparison) isSelected(id string) bool {
+	position := indexOf(c.Rankings, id)
+	return c.IsPartial() && position >= 0 && position < c.Selected
+}
@@ -0,0 +1,178 @@
+package data
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionReviewers(t *testing.T) {
+	newReviewerSession := func(t *testing.T) *Session {
+		session, err := NewSession("Reviewer Session", createTestProposals(), createTestConfig(), "test.csv")
+		require.NoError(t, err)
+		session.SetStorageDirectory(t.TempDir()) // Completed comparisons auto-save
+		return session
+	}
+
+	t.Run("records active reviewer on comparisons", func(t *testing.T) {
+		session := newReviewerSession(t)
+		session.SetReviewer("  alice ")
+		assert.Equal(t, "alice", session.GetReviewer())
+
+		require.NoError(t, session.RecordComparison(createUndoTestComparison("c1", "prop1", "prop2", 1500, 1500)))
+
+		history := session.GetComparisonHistory()
+		require.Len(t, history, 1)
+		assert.Equal(t, "alice", history[0].Reviewer)
+		assert.Equal(t, 1, session.GetReviewerComparisonCount("alice"))
+	})
+
+	t.Run("keeps explicit reviewer", func(t *testing.T) {
+		session := newReviewerSession(t)
+		session.SetReviewer("alice")
+
+		comparison := createUndoTestComparison("c1", "prop1", "prop2", 1500, 1500)
+		comparison.Reviewer = "bob"
+		require.NoError(t, session.RecordComparison(comparison))
+
+		assert.Equal(t, 0, session.GetReviewerComparisonCount("alice"))
+		assert.Equal(t, 1, session.GetReviewerComparisonCount("bob"))
+	})
+
+	t.Run("session workflow records reviewer", func(t *testing.T) {
+		session := newReviewerSession(t)
+		session.SetReviewer("carol")
+
+		require.NoError(t, session.StartComparison([]string{"prop1", "prop2"}, MethodPairwise))
+		comparison, err := session.CompleteComparison("prop1", nil, false, "")
+		require.NoError(t, err)
+		assert.Equal(t, "carol", comparison.Reviewer)
+		assert.Equal(t, 1, session.GetReviewerComparisonCount("carol"))
+	})
+
+	t.Run("per-reviewer counts follow undo and redo", func(t *testing.T) {
+		session := newReviewerSession(t)
+
+		session.SetReviewer("alice")
+		require.NoError(t, session.RecordComparison(createUndoTestComparison("c1", "prop1", "prop2", 1500, 1500)))
+		session.SetReviewer("bob")
+		require.NoError(t, session.RecordComparison(createUndoTestComparison("c2", "prop3", "prop1", 1500, 1516)))
+		session.SetReviewer("")
+		require.NoError(t, session.RecordComparison(createUndoTestComparison("c3", "prop2", "prop3", 1484, 1516)))
+
+		assert.Equal(t, []string{"alice", "bob"}, session.GetReviewers())
+		assert.Equal(t, map[string]int{"alice": 1, "bob": 1}, session.ReviewerCounts)
+
+		_, err := session.UndoLastComparison()
+		require.NoError(t, err)
+		_, err = session.UndoLastComparison()
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int{"alice": 1}, session.ReviewerCounts)
+
+		_, err = session.RedoComparison()
+		require.NoError(t, err)
+		assert.Equal(t, 1, session.GetReviewerComparisonCount("bob"))
+	})
+
+	t.Run("filters comparisons by reviewer", func(t *testing.T) {
+		session := newReviewerSession(t)
+
+		session.SetReviewer("alice")
+		require.NoError(t, session.RecordComparison(createUndoTestComparison("c1", "prop1", "prop2", 1500, 1500)))
+		session.SetReviewer("bob")
+		require.NoError(t, session.RecordComparison(createUndoTestComparison("c2", "prop3", "prop1", 1500, 1516)))
+
+		comparisons := session.GetReviewerComparisons("bob")
+		require.Len(t, comparisons, 1)
+		assert.Equal(t, "c2", comparisons[0].ID)
+		assert.Empty(t, session.GetReviewerComparisons("dave"))
+	})
+
+	t.Run("starting ratings precede first comparison", func(t *testing.T) {
+		session := newReviewerSession(t)
+
+		require.NoError(t, session.RecordComparison(createUndoTestComparison("c1", "prop1", "prop2", 1500, 1500)))
+		require.NoError(t, session.RecordComparison(createUndoTestComparison("c2", "prop1", "prop2", 1516, 1484)))
+
+		assert.Equal(t, map[string]float64{"prop1": 1500, "prop2": 1500, "prop3": 1500}, session.GetStartingRatings())
+	})
+
+	t.Run("reviewer survives save and load", func(t *testing.T) {
+		session := newReviewerSession(t)
+		csvPath := filepath.Join(t.TempDir(), "test.csv")
+		csvContent := `id,title,speaker
+prop1,Test Proposal 1,Speaker 1
+prop2,Test Proposal 2,Speaker 2
+prop3,Test Proposal 3,Speaker 3`
+		require.NoError(t, os.WriteFile(csvPath, []byte(csvContent), 0644))
+		session.InputCSVPath = csvPath
+
+		session.SetReviewer("alice")
+		require.NoError(t, session.RecordComparison(createUndoTestComparison("c1", "prop1", "prop2", 1500, 1500)))
+
+		storage := NewFileStorage()
+		sessionFile := filepath.Join(t.TempDir(), "session.json")
+		require.NoError(t, storage.SaveSession(session, sessionFile))
+
+		loaded, err := storage.LoadSession(sessionFile)
+		require.NoError(t, err)
+		assert.Equal(t, 1, loaded.GetReviewerComparisonCount("alice"))
+		assert.Equal(t, []string{"alice"}, loaded.GetReviewers())
+		assert.Empty(t, loaded.GetReviewer(), "active reviewer is set per run")
+	})
+}
+
+func TestComparisonOutcome(t *testing.T) {
+	tests := []struct {
+		name       string
+		comparison Comparison
+		expected   []string
+		positions  []int
+	}{
+		{
+			name:       "pairwise winner first",
+			comparison: Comparison{ProposalIDs: []string{"a", "b"}, WinnerID: "b"},
+			expected:   []string{"b", "a"},
+		},
+		{
+			name:       "multi-way uses rankings",
+			comparison: Comparison{ProposalIDs: []string{"a", "b", "c"}, WinnerID: "c", Rankings: []string{"c", "a", "b"}},
+			expected:   []string{"c", "a", "b"},
+		},
+		{
+			name:       "draw keeps presented order with shared position",
+			comparison: Comparison{ProposalIDs: []string{"a", "b"}, Draw: true},
+			expected:   []string{"a", "b"},
+			positions:  []int{0, 0},
+		},
+		{
+			name: "rank-top leaves the rest unordered",
+			comparison: Comparison{ProposalIDs: []string{"a", "b", "c", "d"}, WinnerID: "c",
+				Rankings: []string{"c", "a", "d", "b"}, Selected: 2, Method: MethodRankTop},
+			expected:  []string{"c", "a", "d", "b"},
+			positions: []int{0, 1, 2, 2},
+		},
+		{
+			name: "pick-best shares the first position",
+			comparison: Comparison{ProposalIDs: []string{"a", "b", "c", "d"}, WinnerID: "c",
+				Rankings: []string{"c", "a", "d", "b"}, Selected: 2, Method: MethodPickBest},
+			expected:  []string{"c", "a", "d", "b"},
+			positions: []int{0, 0, 2, 2},
+		},
+		{
+			name:       "skipped has no outcome",
+			comparison: Comparison{ProposalIDs: []string{"a", "b"}, Skipped: true},
+			expected:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.comparison.Outcome())
+			assert.Equal(t, tt.positions, tt.comparison.OutcomePositions())
+		})
+	}
+}
@@ -76,8 +76,17 @@ const (
 	MethodTrio ComparisonMethod = "trio"
 	// MethodQuartet compares four proposals at a time
 	MethodQuartet ComparisonMethod = "quartet"
+	// MethodPickBest picks the best K of up to eight proposals without ordering them
+	MethodPickBest ComparisonMethod = "pick-best"
+	// MethodRankTop ranks the top K of up to eight proposals and leaves the rest unordered
+	MethodRankTop ComparisonMethod = "rank-top"
 )
 
+// IsPartial reports whether the method orders only some of the presented proposals
+func (m ComparisonMethod) IsPartial() bool {
+	return m == MethodPickBest || m == MethodRankTop
+}
+
 // Session manages the complete ranking workflow and persistent state
 type Session struct {
 	// Core identity
@@ -87,26 +96,51 @@ type Session struct {
 	UpdatedAt time.Time     `json:"updated_at"` // Last modification timestamp
 
 	// Configuration and data
-	Config         SessionConfig      `json:"config"`          // Session configuration
-	Proposals      []Proposal         `json:"-"`               // Collection of proposals (reloaded from CSV, never serialized)
-	ProposalScores map[string]float64 `json:"proposal_scores"` // Current scores by ID (lightweight persistence)
-	ProposalIndex  map[string]int     `json:"-"`               // Fast ID lookup (not serialized)
-	InputCSVPath   string             `json:"input_csv_path"`  // Original input CSV file path for export
+	Config               SessionConfig      `json:"config"`                          // Session configuration
+	Proposals            []Proposal         `json:"-"`                               // Collection of proposals (reloaded from CSV, never serialized)
+	ProposalScores       map[string]float64 `json:"proposal_scores"`                 // Current scores by ID (lightweight persistence)
+	ProposalDeviations   map[string]float64 `json:"proposal_deviations,omitempty"`   // Rating deviations by ID (Glicko-2 only)
+	ProposalVolatilities map[string]float64 `json:"proposal_volatilities,omitempty"` // Rating volatilities by ID (Glicko-2 only)
+	ProposalIndex        map[string]int     `json:"-"`                               // Fast ID lookup (not serialized)
+	InputCSVPath         string             `json:"input_csv_path"`                  // Original input CSV file path for export
 
 	// Comparison tracking (lightweight persistence for progress/confidence)
-	ComparisonCounts     map[string]int   `json:"comparison_counts"` // Per-proposal comparison count for confidence
-	TotalComparisons     int              `json:"total_comparisons"` // Total comparisons performed for progress
-	CurrentComparison    *ComparisonState `json:"-"`                 // Active comparison state (not persisted)
-	CompletedComparisons []Comparison     `json:"-"`                 // Historical comparisons (not persisted)
+	ComparisonCounts     map[string]int   `json:"comparison_counts"`         // Per-proposal comparison count for confidence
+	TotalComparisons     int              `json:"total_comparisons"`         // Total comparisons performed for progress
+	ReviewerCounts       map[string]int   `json:"reviewer_counts,omitempty"` // Per-reviewer comparison count
+	CurrentComparison    *ComparisonState `json:"-"`                         // Active comparison state (not persisted)
+	CompletedComparisons []Comparison     `json:"-"`                         // Historical comparisons (persisted in the history sidecar log)
 
 	// Analytics and optimization
 	ConvergenceMetrics *ConvergenceMetrics `json:"convergence_metrics"` // Progress tracking
-	MatchupHistory     []MatchupHistory    `json:"matchup_history"`     // Pairing optimization data
-	RatingBins         []RatingBin         `json:"rating_bins"`         // Strategic grouping
+
+	// Reviewer notes edited in this session, overriding the CSV comment column (empty clears it)
+	Notes map[string]string `json:"notes,omitempty"` // Note by proposal ID
+
+	// Reviewer labels applied during review (proposals are reloaded from CSV without them)
+	Labels map[string][]string `json:"labels,omitempty"` // Labels by proposal ID
+
+	// Triage pre-pass
+	Triage      map[string]Bucket `json:"triage,omitempty"`       // Triage bucket by proposal ID
+	ExcludeWeak bool              `json:"exclude_weak,omitempty"` // Keep proposals triaged as weak out of matchups
+
+	MatchupHistory []MatchupHistory `json:"matchup_history"` // Pairing optimization data
+	RatingBins     []RatingBin      `json:"rating_bins"`     // Strategic grouping
 
 	// Internal state management
-	mutex            sync.RWMutex `json:"-"` // Thread safety (not serialized)
-	storageDirectory string       `json:"-"` // Where to persist session
+	mutex            sync.RWMutex  `json:"-"` // Thread safety (not serialized)
+	storageDirectory string        `json:"-"` // Where to persist session
+	history          historyCursor `json:"-"` // Position of the comparison history sidecar log
+	redoStack        []Comparison  `json:"-"` // Undone comparisons available for redo (not persisted)
+	reviewer         string        `json:"-"` // Reviewer attributed to new comparisons (set per run)
+	conflicts        []string      `json:"-"` // Reviewer conflict tags excluding proposals (set per run)
+}
+
+// historyCursor tracks which completed comparisons are already in the history sidecar log
+type historyCursor struct {
+	file   string // Sidecar log the comparisons were written to
+	count  int    // Number of comparisons already written
+	lastID string // ID of the last written comparison (detects rewritten history)
 }
 
 // ComparisonState represents the current active comparison
@@ -120,28 +154,36 @@ type ComparisonState struct {
 
 // Comparison records a completed evaluation event between proposals
 type Comparison struct {
-	ID          string           `json:"id"`           // Unique comparison identifier
-	SessionName string           `json:"session_name"` // Parent session name
-	ProposalIDs []string         `json:"proposal_ids"` // Proposals that were compared
-	WinnerID    string           `json:"winner_id"`    // Selected best proposal ID (empty if skipped)
-	Rankings    []string         `json:"rankings"`     // Full ranking order for multi-proposal (optional)
-	Method      ComparisonMethod `json:"method"`       // Comparison type
-	Timestamp   time.Time        `json:"timestamp"`    // When comparison was completed
-	Duration    time.Duration    `json:"duration"`     // Time spent on comparison
-	Skipped     bool             `json:"skipped"`      // Whether comparison was skipped
-	SkipReason  string           `json:"skip_reason"`  // Why comparison was skipped (optional)
-	EloUpdates  []EloUpdate      `json:"elo_updates"`  // Rating changes from this comparison
+	ID          string           `json:"id"`                 // Unique comparison identifier
+	SessionName string           `json:"session_name"`       // Parent session name
+	Reviewer    string           `json:"reviewer,omitempty"` // Reviewer who made the comparison (optional)
+	ProposalIDs []string         `json:"proposal_ids"`       // Proposals that were compared
+	WinnerID    string           `json:"winner_id"`          // Selected best proposal ID (empty if skipped or drawn)
+	Draw        bool             `json:"draw,omitempty"`     // Whether the proposals were judged equally good
+	Rankings    []string         `json:"rankings"`           // Full ranking order for multi-proposal (optional)
+	Selected    int              `json:"selected,omitempty"` // Leading Rankings entries picked or ranked (pick-best and rank-top only)
+	Method      ComparisonMethod `json:"method"`             // Comparison type
+	Timestamp   time.Time        `json:"timestamp"`          // When comparison was completed
+	Duration    time.Duration    `json:"duration"`           // Time spent on comparison
+	Skipped     bool             `json:"skipped"`            // Whether comparison was skipped
+	SkipReason  string           `json:"skip_reason"`        // Why comparison was skipped (optional)
+	Note        string           `json:"note,omitempty"`     // Why the reviewer decided this way (optional)
+	EloUpdates  []EloUpdate      `json:"elo_updates"`        // Rating changes from this comparison
 }
 
 // EloUpdate records rating changes from a single comparison
 type EloUpdate struct {
-	ID           string  `json:"id"`            // Unique update identifier
-	ComparisonID string  `json:"comparison_id"` // Parent comparison
-	ProposalID   string  `json:"proposal_id"`   // Affected proposal
-	OldRating    float64 `json:"old_rating"`    // Rating before comparison
-	NewRating    float64 `json:"new_rating"`    // Rating after comparison
-	RatingDelta  float64 `json:"rating_delta"`  // Change amount (NewRating - OldRating)
-	KFactor      int     `json:"k_factor"`      // K-factor used for this calculation
+	ID            string  `json:"id"`                       // Unique update identifier
+	ComparisonID  string  `json:"comparison_id"`            // Parent comparison
+	ProposalID    string  `json:"proposal_id"`              // Affected proposal
+	OldRating     float64 `json:"old_rating"`               // Rating before comparison
+	NewRating     float64 `json:"new_rating"`               // Rating after comparison
+	RatingDelta   float64 `json:"rating_delta"`             // Change amount (NewRating - OldRating)
+	KFactor       int     `json:"k_factor"`                 // K-factor used for this calculation
+	OldDeviation  float64 `json:"old_deviation,omitempty"`  // Rating deviation before comparison (Glicko-2 only)
+	NewDeviation  float64 `json:"new_deviation,omitempty"`  // Rating deviation after comparison (Glicko-2 only)
+	OldVolatility float64 `json:"old_volatility,omitempty"` // Volatility before comparison (Glicko-2 only)
+	NewVolatility float64 `json:"new_volatility,omitempty"` // Volatility after comparison (Glicko-2 only)
 }
 
 // ConvergenceMetrics tracks session progress and convergence indicators
@@ -150,10 +192,14 @@ type ConvergenceMetrics struct {
 	AvgRatingChange     float64   `json:"avg_rating_change"`     // Rolling average of rating changes
 	RatingVariance      float64   `json:"rating_variance"`       // Variance in recent rating changes
 	RankingStability    float64   `json:"ranking_stability"`     // Percentage of stable top-N positions
-	CoveragePercentage  float64   `json:"coverage_percentage"`   // Percentage of meaningful pairs compared
-	ConvergenceScore    float64   `json:"convergence_score"`     // Overall convergence indicator 0-1
+	CoveragePercentage  float64   `json:"coverage_percentage"`   // Percentage of the minimum per-proposal coverage reached
+	ConvergenceScore    float64   `json:"convergence_score"`     // Share of stopping criteria met (confidence) 0-1
 	LastCalculated      time.Time `json:"last_calculated"`       // When metrics were last updated
 	RecentRatingChanges []float64 `json:"recent_rating_changes"` // Last N rating changes for variance calc
+
+	CriteriaMet       map[string]bool `json:"criteria_met,omitempty"` // Stopping criteria and whether each is met
+	RemainingEstimate int             `json:"remaining_estimate"`     // Estimated comparisons until convergence
+	ShouldStop        bool            `json:"should_stop"`            // Whether stopping is recommended
 }
 
 // MatchupHistory tracks comparison pairings to optimize future matchup selection
@@ -389,6 +435,12 @@ func (s *Session) StartComparison(proposalIDs []string, method ComparisonMethod)
 		expectedCount = 3
 	case MethodQuartet:
 		expectedCount = 4
+	case MethodPickBest, MethodRankTop:
+		if len(proposalIDs) < 3 || len(proposalIDs) > MaxGroupSize {
+			return fmt.Errorf("%w: method %s requires 3 to %d proposals, got %d",
+				ErrInvalidComparison, method, MaxGroupSize, len(proposalIDs))
+		}
+		expectedCount = len(proposalIDs)
 	default:
 		return fmt.Errorf("%w: unknown comparison method: %s", ErrInvalidComparison, method)
 	}
@@ -471,6 +523,7 @@ func (s *Session) completeComparisonInternal(winnerID string, rankings []string,
 	comparison := &Comparison{
 		ID:          s.CurrentComparison.ID,
 		SessionName: s.Name,
+		Reviewer:    s.reviewer,
 		ProposalIDs: make([]string, len(s.CurrentComparison.ProposalIDs)),
 		WinnerID:    winnerID,
 		Rankings:    rankings,
@@ -527,6 +580,10 @@ func (s *Session) completeComparisonInternal(winnerID string, rankings []string,
 
 	// Add to completed comparisons
 	s.CompletedComparisons = append(s.CompletedComparisons, *comparison)
+	s.countComparison(*comparison, 1)
+
+	// A new comparison invalidates anything that was undone before it
+	s.redoStack = nil
 
 	// Clear current comparison
 	s.CurrentComparison = nil
@@ -620,6 +677,12 @@ func (s *Session) GetConvergenceMetrics() *ConvergenceMetrics {
 	metrics := *s.ConvergenceMetrics
 	metrics.RecentRatingChanges = make([]float64, len(s.ConvergenceMetrics.RecentRatingChanges))
 	copy(metrics.RecentRatingChanges, s.ConvergenceMetrics.RecentRatingChanges)
+	if s.ConvergenceMetrics.CriteriaMet != nil {
+		metrics.CriteriaMet = make(map[string]bool, len(s.ConvergenceMetrics.CriteriaMet))
+		for criterion, met := range s.ConvergenceMetrics.CriteriaMet {
+			metrics.CriteriaMet[criterion] = met
+		}
+	}
 
 	return &metrics
 }
@@ -823,61 +886,26 @@ func (s *Session) GetMatchupHistory() []MatchupHistory {
 	return history
 }
 
-// calculateConvergenceMetrics performs detailed convergence analysis
+// calculateConvergenceMetrics stores the engine's convergence evaluation in the metrics
 func (s *Session) calculateConvergenceMetrics() {
 	if s.ConvergenceMetrics == nil {
 		return
 	}
 
-	metrics := s.ConvergenceMetrics
-
-	// Calculate average rating change from recent changes
-	if len(metrics.RecentRatingChanges) > 0 {
-		sum := 0.0
-		for _, change := range metrics.RecentRatingChanges {
-			if change < 0 {
-				change = -change
-			}
-			sum += change
-		}
-		metrics.AvgRatingChange = sum / float64(len(metrics.RecentRatingChanges))
-
-		// Calculate variance
-		if len(metrics.RecentRatingChanges) > 1 {
-			variance := 0.0
-			for _, change := range metrics.RecentRatingChanges {
-				if change < 0 {
-					change = -change
-				}
-				diff := change - metrics.AvgRatingChange
-				variance += diff * diff
-			}
-			metrics.RatingVariance = variance / float64(len(metrics.RecentRatingChanges)-1)
-		}
-	}
-
-	// Calculate coverage percentage (simplified)
-	totalPossiblePairs := len(s.Proposals) * (len(s.Proposals) - 1) / 2
-	uniquePairs := len(s.MatchupHistory)
-	if totalPossiblePairs > 0 {
-		metrics.CoveragePercentage = float64(uniquePairs) / float64(totalPossiblePairs) * 100.0
-	}
-
-	// Calculate convergence score (0-1)
-	// Simple heuristic: high coverage + low variance = high convergence
-	coverageFactor := metrics.CoveragePercentage / 100.0
-	varianceFactor := 1.0
-	if metrics.RatingVariance > 0 {
-		varianceFactor = 1.0 / (1.0 + metrics.RatingVariance/10.0) // Normalize variance impact
-	}
-
-	metrics.ConvergenceScore = (coverageFactor + varianceFactor) / 2.0
-	if metrics.ConvergenceScore > 1.0 {
-		metrics.ConvergenceScore = 1.0
+	status := s.checkConvergence()
+	if status == nil {
+		return
 	}
 
-	// TODO: Implement more sophisticated ranking stability calculation
-	metrics.RankingStability = 0.0 // Placeholder
+	metrics := s.ConvergenceMetrics
+	metrics.AvgRatingChange = status.Metrics.AvgRatingChange
+	metrics.RatingVariance = status.Metrics.RatingVariance
+	metrics.RankingStability = status.Metrics.RankingStability * 100.0
+	metrics.CoveragePercentage = status.Metrics.CoveragePercentage * 100.0
+	metrics.ConvergenceScore = status.Confidence
+	metrics.CriteriaMet = status.CriteriaMet
+	metrics.RemainingEstimate = status.RemainingEstimate
+	metrics.ShouldStop = status.ShouldStop
 }
 
 // generateUpdateID creates a unique identifier for an Elo update
@@ -940,6 +968,11 @@ func ValidateSessionFile(sessionName, storageDir string) error {
 		}
 	}
 
+	// The comparison history log must be readable too (a truncated last record is repaired on save)
+	if _, _, err := loadComparisonHistory(HistoryFilename(sessionFile)); err != nil {
+		return fmt.Errorf("%w: %v", ErrSessionCorrupted, err)
+	}
+
 	return nil
 }
 
@@ -962,27 +995,40 @@ func GetSessionInfo(sessionName, storageDir string) (*SessionInfo, error) {
 		return nil, fmt.Errorf("failed to read session file: %w", err)
 	}
 
-	// Parse just the fields we need
+	// Parse just the fields we need (proposals live in the CSV, comparisons in the history log)
 	var partial struct {
-		Name                 string     `json:"name"`
-		Status               string     `json:"status"`
-		CreatedAt            time.Time  `json:"created_at"`
-		UpdatedAt            time.Time  `json:"updated_at"`
-		Proposals            []struct{} `json:"proposals"`
-		CompletedComparisons []struct{} `json:"completed_comparisons"`
+		Name             string             `json:"name"`
+		Status           string             `json:"status"`
+		CreatedAt        time.Time          `json:"created_at"`
+		UpdatedAt        time.Time          `json:"updated_at"`
+		InputCSVPath     string             `json:"input_csv_path"`
+		ProposalScores   map[string]float64 `json:"proposal_scores"`
+		TotalComparisons int                `json:"total_comparisons"`
 	}
 
 	if err := json.Unmarshal(data, &partial); err != nil {
 		return nil, fmt.Errorf("%w: failed to parse session metadata", ErrSessionCorrupted)
 	}
 
+	// Count comparisons in the history log; sessions without a log only know their total
+	comparisonCount := partial.TotalComparisons
+	historyFile := HistoryFilename(sessionFile)
+	if _, err := os.Stat(historyFile); err == nil {
+		comparisons, _, err := loadComparisonHistory(historyFile)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrSessionCorrupted, err)
+		}
+		comparisonCount = len(comparisons)
+	}
+
 	return &SessionInfo{
 		Name:            partial.Name,
 		Status:          SessionStatus(partial.Status),
 		CreatedAt:       partial.CreatedAt,
 		UpdatedAt:       partial.UpdatedAt,
-		ProposalCount:   len(partial.Proposals),
-		ComparisonCount: len(partial.CompletedComparisons),
+		InputCSVPath:    partial.InputCSVPath,
+		ProposalCount:   len(partial.ProposalScores),
+		ComparisonCount: comparisonCount,
 		FileSize:        stat.Size(),
 		LastModified:    stat.ModTime(),
 	}, nil
@@ -994,6 +1040,7 @@ type SessionInfo struct {
 	Status          SessionStatus `json:"status"`
 	CreatedAt       time.Time     `json:"created_at"`
 	UpdatedAt       time.Time     `json:"updated_at"`
+	InputCSVPath    string        `json:"input_csv_path"`
 	ProposalCount   int           `json:"proposal_count"`
 	ComparisonCount int           `json:"comparison_count"`
 	FileSize        int64         `json:"file_size"`
@@ -1012,6 +1059,11 @@ func DeleteSession(sessionName, storageDir string) error {
 		return fmt.Errorf("failed to remove session file: %w", err)
 	}
 
+	// Remove comparison history log
+	if err := os.Remove(HistoryFilename(sessionFile)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove session history: %w", err)
+	}
+
 	// Remove backup files
 	pattern := filepath.Join(storageDir, SanitizeFilename(sessionName)+"_backup_*.json")
 	backups, err := filepath.Glob(pattern)
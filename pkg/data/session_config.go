@@ -6,6 +6,8 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+
+	"github.com/pashagolub/confelo/pkg/elo"
 )
 
 // Error types for configuration validation
@@ -14,6 +16,7 @@ var (
 	ErrInvalidEloConfig    = errors.New("invalid Elo configuration")
 	ErrInvalidUIConfig     = errors.New("invalid UI configuration")
 	ErrInvalidExportConfig = errors.New("invalid export configuration")
+	ErrInvalidGroupConfig  = errors.New("invalid group configuration")
 )
 
 // SessionConfig is the top-level configuration for a ranking session
@@ -23,6 +26,7 @@ type SessionConfig struct {
 	UI          UIConfig          `json:"ui"`
 	Export      ExportConfig      `json:"export"`
 	Convergence ConvergenceConfig `json:"convergence"`
+	Groups      GroupConfig       `json:"groups"`
 }
 
 // CSVConfig defines how to parse input CSV files
@@ -40,25 +44,41 @@ type CSVConfig struct {
 
 // EloConfig holds settings for Elo rating calculations
 type EloConfig struct {
-	InitialRating float64 `json:"initial_rating"` // Starting rating for new proposals (default 1500)
-	KFactor       int     `json:"k_factor"`       // Rating change sensitivity (default 32)
-	MinRating     float64 `json:"min_rating"`     // Minimum allowed rating (default 0)
-	MaxRating     float64 `json:"max_rating"`     // Maximum allowed rating (default 3000)
-	OutputMin     float64 `json:"output_min"`     // Minimum output scale value
-	OutputMax     float64 `json:"output_max"`     // Maximum output scale value
-	UseDecimals   bool    `json:"use_decimals"`   // Whether output uses decimal places
+	InitialRating float64 `json:"initial_rating"`          // Starting rating for new proposals (default 1500)
+	KFactor       int     `json:"k_factor"`                // Rating change sensitivity (default 32)
+	MinRating     float64 `json:"min_rating"`              // Minimum allowed rating (default 0)
+	MaxRating     float64 `json:"max_rating"`              // Maximum allowed rating (default 3000)
+	OutputMin     float64 `json:"output_min"`              // Minimum output scale value
+	OutputMax     float64 `json:"output_max"`              // Maximum output scale value
+	UseDecimals   bool    `json:"use_decimals"`            // Whether output uses decimal places
+	RatingSystem  string  `json:"rating_system,omitempty"` // Rating algorithm: elo or glicko2 (empty means elo)
 }
 
+// Supported rating systems
+const (
+	RatingSystemElo     = "elo"     // Classic fixed-K Elo
+	RatingSystemGlicko2 = "glicko2" // Glicko-2 with rating deviation and volatility
+)
+
+// Group sizes of pick-best and rank-top comparisons
+const (
+	MaxGroupSize     = elo.MaxGroupSize // Most proposals a comparison can present
+	DefaultGroupSize = 6                // Proposals presented when the session does not say
+	DefaultPickCount = 3                // Proposals picked or ranked when the session does not say
+)
+
 // UIConfig holds terminal interface preferences
 type UIConfig struct {
-	ComparisonMode string `json:"comparison_mode"` // Default comparison type (pairwise/trio/quartet)
-	ShowProgress   bool   `json:"show_progress"`   // Display progress indicators
-	ShowConfidence bool   `json:"show_confidence"` // Display rating confidence
+	ComparisonMode string `json:"comparison_mode"`      // Default comparison type (pairwise/trio/quartet/pick-best/rank-top)
+	GroupSize      int    `json:"group_size,omitempty"` // Proposals presented by pick-best and rank-top (0 uses the default)
+	PickCount      int    `json:"pick_count,omitempty"` // Proposals picked or ranked by pick-best and rank-top (0 uses the default)
+	ShowProgress   bool   `json:"show_progress"`        // Display progress indicators
+	ShowConfidence bool   `json:"show_confidence"`      // Display rating confidence
 }
 
 // ExportConfig holds output format settings
 type ExportConfig struct {
-	Format          string `json:"format"`           // Output format (csv/json/yaml)
+	Format          string `json:"format"`           // Output format (csv/json/yaml/markdown/html)
 	IncludeMetadata bool   `json:"include_metadata"` // Include original CSV metadata
 	SortBy          string `json:"sort_by"`          // Sort criterion (rating/title/speaker)
 	SortOrder       string `json:"sort_order"`       // Sort direction (asc/desc)
@@ -69,6 +89,7 @@ type ExportConfig struct {
 // ConvergenceConfig holds settings for intelligent stopping criteria
 type ConvergenceConfig struct {
 	TargetAccepted      int     `json:"target_accepted"`        // Number of talks to be accepted (T)
+	WaitlistSize        int     `json:"waitlist_size"`          // Number of talks after the accepted ones put on the waitlist
 	TopTStabilityWindow int     `json:"top_t_stability_window"` // Window to check top-T stability
 	StabilityThreshold  float64 `json:"stability_threshold"`    // Min rating change to consider stable
 	MinComparisons      int     `json:"min_comparisons"`        // Minimum comparisons before convergence check
@@ -77,6 +98,13 @@ type ConvergenceConfig struct {
 	ConfidenceThreshold float64 `json:"confidence_threshold"`   // Min confidence to recommend stopping
 }
 
+// GroupConfig holds settings for track-aware ranking with per-group quotas
+type GroupConfig struct {
+	Column         string         `json:"column,omitempty"` // Metadata column proposals are grouped by (empty disables grouping)
+	Quotas         map[string]int `json:"quotas,omitempty"` // Number of talks to accept per group (groups without a quota accept none)
+	CrossGroupRate float64        `json:"cross_group_rate"` // Share of comparisons that calibrate across groups
+}
+
 // DefaultSessionConfig returns a configuration with sensible defaults
 func DefaultSessionConfig() SessionConfig {
 	return SessionConfig{
@@ -85,6 +113,7 @@ func DefaultSessionConfig() SessionConfig {
 		UI:          DefaultUIConfig(),
 		Export:      DefaultExportConfig(),
 		Convergence: DefaultConvergenceConfig(),
+		Groups:      DefaultGroupConfig(),
 	}
 }
 
@@ -141,6 +170,7 @@ func DefaultExportConfig() ExportConfig {
 func DefaultConvergenceConfig() ConvergenceConfig {
 	return ConvergenceConfig{
 		TargetAccepted:      10,   // Typical conference acceptance: 10-20 talks
+		WaitlistSize:        5,    // Backups for speakers who decline
 		TopTStabilityWindow: 5,    // Check stability over last 5 comparisons
 		StabilityThreshold:  5.0,  // <5 point rating changes considered stable
 		MinComparisons:      20,   // Minimum comparisons before early stopping
@@ -150,6 +180,13 @@ func DefaultConvergenceConfig() ConvergenceConfig {
 	}
 }
 
+// DefaultGroupConfig returns grouping defaults (grouping disabled)
+func DefaultGroupConfig() GroupConfig {
+	return GroupConfig{
+		CrossGroupRate: 0.2, // One in five comparisons keeps the groups on a common scale
+	}
+}
+
 // Validate checks that the session configuration is valid
 func (sc *SessionConfig) Validate() error {
 	if err := sc.CSV.Validate(); err != nil {
@@ -168,6 +205,10 @@ func (sc *SessionConfig) Validate() error {
 		return fmt.Errorf("export config validation failed: %w", err)
 	}
 
+	if err := sc.Groups.Validate(); err != nil {
+		return fmt.Errorf("group config validation failed: %w", err)
+	}
+
 	return nil
 }
 
@@ -275,6 +316,16 @@ func (e *EloConfig) CalculateExportScore(eloScore float64) float64 {
 	return exportScore
 }
 
+// EngineConfig returns the rating engine settings of the configuration
+func (e *EloConfig) EngineConfig() elo.Config {
+	return elo.Config{
+		InitialRating: e.InitialRating,
+		KFactor:       e.KFactor,
+		MinRating:     e.MinRating,
+		MaxRating:     e.MaxRating,
+	}
+}
+
 // Validate checks that Elo configuration is valid
 func (e *EloConfig) Validate() error {
 	// K-factor validation
@@ -301,22 +352,58 @@ func (e *EloConfig) Validate() error {
 		return fmt.Errorf("%w: output_min (%.2f) must be less than output_max (%.2f)", ErrInvalidEloConfig, e.OutputMin, e.OutputMax)
 	}
 
+	// Rating system validation (empty keeps classic Elo for older sessions)
+	switch e.RatingSystem {
+	case "", RatingSystemElo, RatingSystemGlicko2:
+	default:
+		return fmt.Errorf("%w: rating_system '%s' must be one of: elo, glicko2", ErrInvalidEloConfig, e.RatingSystem)
+	}
+
 	return nil
 }
 
+// PartialGroup returns the group size and pick count of pick-best and rank-top comparisons
+func (u *UIConfig) PartialGroup() (size, picks int) {
+	size, picks = u.GroupSize, u.PickCount
+	if size == 0 {
+		size = DefaultGroupSize
+	}
+	if picks == 0 {
+		picks = min(DefaultPickCount, size-1)
+	}
+	return size, picks
+}
+
 // Validate checks that UI configuration is valid
 func (u *UIConfig) Validate() error {
 	// Comparison mode validation
 	validModes := map[string]bool{
-		"pairwise": true,
-		"trio":     true,
-		"quartet":  true,
+		"pairwise":  true,
+		"trio":      true,
+		"quartet":   true,
+		"pick-best": true,
+		"rank-top":  true,
 	}
 
 	if !validModes[u.ComparisonMode] {
-		return fmt.Errorf("%w: comparison_mode '%s' must be one of: pairwise, trio, quartet", ErrInvalidUIConfig, u.ComparisonMode)
+		return fmt.Errorf("%w: comparison_mode '%s' must be one of: pairwise, trio, quartet, pick-best, rank-top", ErrInvalidUIConfig, u.ComparisonMode)
 	}
 
+	if err := validatePartialGroup(u.PartialGroup()); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidUIConfig, err)
+	}
+
+	return nil
+}
+
+// validatePartialGroup checks the group size and pick count of pick-best and rank-top comparisons
+func validatePartialGroup(size, picks int) error {
+	if size < 3 || size > MaxGroupSize {
+		return fmt.Errorf("group size %d must be between 3 and %d", size, MaxGroupSize)
+	}
+	if picks < 1 || picks >= size {
+		return fmt.Errorf("pick count %d must be between 1 and %d for groups of %d", picks, size-1, size)
+	}
 	return nil
 }
 
@@ -324,13 +411,15 @@ func (u *UIConfig) Validate() error {
 func (e *ExportConfig) Validate() error {
 	// Format validation
 	validFormats := map[string]bool{
-		"csv":  true,
-		"json": true,
-		"yaml": true,
+		ExportFormatCSV:      true,
+		ExportFormatJSON:     true,
+		ExportFormatYAML:     true,
+		ExportFormatMarkdown: true,
+		ExportFormatHTML:     true,
 	}
 
 	if !validFormats[e.Format] {
-		return fmt.Errorf("%w: format '%s' must be one of: csv, json, yaml", ErrInvalidExportConfig, e.Format)
+		return fmt.Errorf("%w: format '%s' must be one of: csv, json, yaml, markdown, html", ErrInvalidExportConfig, e.Format)
 	}
 
 	// Sort criteria validation
@@ -362,3 +451,22 @@ func (e *ExportConfig) Validate() error {
 
 	return nil
 }
+
+// Validate checks that group configuration is valid
+func (g *GroupConfig) Validate() error {
+	if g.Column == "" && len(g.Quotas) > 0 {
+		return fmt.Errorf("%w: quotas require a group column", ErrInvalidGroupConfig)
+	}
+
+	for group, quota := range g.Quotas {
+		if quota < 0 {
+			return fmt.Errorf("%w: quota %d of group '%s' must not be negative", ErrInvalidGroupConfig, quota, group)
+		}
+	}
+
+	if g.CrossGroupRate < 0 || g.CrossGroupRate > 1 {
+		return fmt.Errorf("%w: cross_group_rate %.2f must be between 0 and 1", ErrInvalidGroupConfig, g.CrossGroupRate)
+	}
+
+	return nil
+}
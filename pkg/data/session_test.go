@@ -222,6 +222,7 @@ func TestComparisonManagement(t *testing.T) {
 
 	session, err := NewSession("Test Session", proposals, config, "test.csv")
 	require.NoError(t, err)
+	session.SetStorageDirectory(t.TempDir()) // Completed comparisons auto-save
 
 	t.Run("Start pairwise comparison", func(t *testing.T) {
 		err := session.StartComparison([]string{"prop1", "prop2"}, MethodPairwise)
@@ -560,6 +561,23 @@ func TestSessionManagementUtils(t *testing.T) {
 		assert.Error(t, err)
 	})
 
+	t.Run("Session info counts proposals and logged comparisons", func(t *testing.T) {
+		require.NoError(t, session1.StartComparison([]string{"prop1", "prop2"}, MethodPairwise))
+		_, err := session1.CompleteComparison("prop1", nil, false, "")
+		require.NoError(t, err)
+		require.NoError(t, session1.Save())
+
+		info, err := GetSessionInfo(session1.Name, tempDir)
+		require.NoError(t, err)
+		assert.Equal(t, session1.Name, info.Name)
+		assert.Equal(t, "test.csv", info.InputCSVPath)
+		assert.Equal(t, len(proposals), info.ProposalCount)
+		assert.Equal(t, 1, info.ComparisonCount)
+
+		_, err = GetSessionInfo("nonexistent", tempDir)
+		assert.ErrorIs(t, err, ErrSessionNotFound)
+	})
+
 	t.Run("Delete session", func(t *testing.T) {
 		err := DeleteSession(session2.Name, tempDir)
 		require.NoError(t, err)
@@ -0,0 +1,109 @@
+// Package data provides simulated ranking sessions for tuning matchup and convergence settings.
+// Proposals get hidden true strengths and a noisy simulated reviewer answers the comparisons,
+// so the accuracy of every strategy, comparison mode and K-factor can be measured.
+package data
+
+import (
+	"fmt"
+
+	"github.com/pashagolub/confelo/pkg/elo"
+)
+
+// Matchup strategies the simulation can evaluate
+const (
+	StrategyInformationGain = "information-gain" // Close, under-compared proposals (used by the comparison screen)
+	StrategySequential      = "sequential"       // Proposals in input order
+)
+
+// SimulationSettings describes the simulated sessions to run
+type SimulationSettings struct {
+	Strategies  []string           // Matchup strategies to evaluate
+	Methods     []ComparisonMethod // Comparison modes to evaluate
+	KFactors    []int              // Elo K-factors to evaluate
+	Comparisons int                // Comparisons per simulated session
+	Step        int                // Comparisons between two accuracy measurements
+	Noise       float64            // Standard deviation of the reviewer's perception, in rating points
+	Spread      float64            // Standard deviation of the hidden true strengths, in rating points
+	Seed        int64              // Seed of the true strengths and the reviewer's noise
+	Elo         EloConfig          // Initial rating and bounds of the simulated sessions
+	Convergence ConvergenceConfig  // Stopping criteria whose recommendation is reported
+}
+
+// SimulationRun is the result of one simulated session
+type SimulationRun struct {
+	Strategy string                // Matchup strategy of the session
+	Method   ComparisonMethod      // Comparison mode of the session
+	KFactor  int                   // Elo K-factor of the session
+	Result   *elo.SimulationResult // Accuracy over time and the recommended stopping point
+}
+
+// GroupSize returns the number of proposals presented by a comparison method
+func (m ComparisonMethod) GroupSize() int {
+	switch m {
+	case MethodTrio:
+		return 3
+	case MethodQuartet:
+		return 4
+	default:
+		return 2
+	}
+}
+
+// SimulateProposals runs a simulated session for every combination of strategy, comparison
+// method and K-factor. All sessions share the same hidden strengths and reviewer noise.
+func SimulateProposals(proposals []Proposal, settings SimulationSettings) ([]SimulationRun, error) {
+	ids := make([]string, len(proposals))
+	for i, proposal := range proposals {
+		ids[i] = proposal.ID
+	}
+
+	runs := make([]SimulationRun, 0, len(settings.Strategies)*len(settings.Methods)*len(settings.KFactors))
+	for _, kFactor := range settings.KFactors {
+		engineConfig := settings.Elo.EngineConfig()
+		engineConfig.KFactor = kFactor
+		engine, err := elo.NewEngine(engineConfig)
+		if err != nil {
+			return nil, fmt.Errorf("K-factor %d: %w", kFactor, err)
+		}
+		truth := engine.TrueStrengths(ids, settings.Spread, settings.Seed)
+
+		for _, name := range settings.Strategies {
+			for _, method := range settings.Methods {
+				strategy, err := simulationStrategy(name, engine)
+				if err != nil {
+					return nil, err
+				}
+
+				result, err := engine.Simulate(truth, elo.SimulationConfig{
+					Strategy:    strategy,
+					GroupSize:   method.GroupSize(),
+					Comparisons: settings.Comparisons,
+					Step:        settings.Step,
+					Noise:       settings.Noise,
+					TopT:        settings.Convergence.TargetAccepted,
+					Convergence: settings.Convergence.OptimizationConfig(),
+					Seed:        settings.Seed,
+				})
+				if err != nil {
+					return nil, fmt.Errorf("%s %s K=%d: %w", name, method, kFactor, err)
+				}
+
+				runs = append(runs, SimulationRun{Strategy: name, Method: method, KFactor: kFactor, Result: result})
+			}
+		}
+	}
+
+	return runs, nil
+}
+
+// simulationStrategy creates the matchup strategy with the given name
+func simulationStrategy(name string, engine *elo.Engine) (elo.MatchupStrategy, error) {
+	switch name {
+	case StrategyInformationGain:
+		return elo.NewInformationGainStrategy(engine, elo.DefaultOptimizationConfig()), nil
+	case StrategySequential:
+		return elo.SequentialStrategy{}, nil
+	default:
+		return nil, fmt.Errorf("unknown matchup strategy '%s': must be %s or %s", name, StrategyInformationGain, StrategySequential)
+	}
+}
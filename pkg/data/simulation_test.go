@@ -0,0 +1,65 @@
+package data
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulateProposals(t *testing.T) {
+	config := DefaultSessionConfig()
+	result, err := NewFileStorage().LoadProposalsFromCSVWithElo(
+		filepath.Join("..", "..", "testdata", "proposals-small.csv"), config.CSV, &config.Elo)
+	require.NoError(t, err)
+
+	settings := SimulationSettings{
+		Strategies:  []string{StrategyInformationGain, StrategySequential},
+		Methods:     []ComparisonMethod{MethodPairwise, MethodQuartet},
+		KFactors:    []int{16, 32},
+		Comparisons: 40,
+		Step:        10,
+		Noise:       50,
+		Spread:      200,
+		Seed:        7,
+		Elo:         config.Elo,
+		Convergence: config.Convergence,
+	}
+	settings.Convergence.TargetAccepted = 3
+
+	runs, err := SimulateProposals(result.Proposals, settings)
+	require.NoError(t, err)
+	require.Len(t, runs, 8)
+
+	assert.Equal(t, StrategyInformationGain, runs[0].Strategy)
+	assert.Equal(t, MethodPairwise, runs[0].Method)
+	assert.Equal(t, 16, runs[0].KFactor)
+	assert.Equal(t, MethodQuartet, runs[1].Method)
+	assert.Equal(t, StrategySequential, runs[2].Strategy)
+	assert.Equal(t, 32, runs[7].KFactor)
+
+	for _, run := range runs {
+		require.NotEmpty(t, run.Result.Points)
+		last := run.Result.Points[len(run.Result.Points)-1]
+		assert.Greater(t, last.KendallTau, 0.0, "%s %s K=%d ranks better than chance", run.Strategy, run.Method, run.KFactor)
+	}
+
+	t.Run("same seed gives the same result", func(t *testing.T) {
+		again, err := SimulateProposals(result.Proposals, settings)
+		require.NoError(t, err)
+		assert.Equal(t, runs, again)
+	})
+
+	t.Run("unknown strategy", func(t *testing.T) {
+		settings.Strategies = []string{"random"}
+		_, err := SimulateProposals(result.Proposals, settings)
+		assert.Error(t, err)
+	})
+}
+
+func TestComparisonMethod_GroupSize(t *testing.T) {
+	assert.Equal(t, 2, MethodPairwise.GroupSize())
+	assert.Equal(t, 3, MethodTrio.GroupSize())
+	assert.Equal(t, 4, MethodQuartet.GroupSize())
+}
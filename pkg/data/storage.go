@@ -144,6 +144,7 @@ func (fs *FileStorage) parseCSVFromReader(reader io.Reader, config CSVConfig, el
 	abstractCol := fs.findColumn(config.AbstractColumn, columnMap)
 	scoreCol := fs.findColumn(config.ScoreColumn, columnMap)
 	conflictCol := fs.findColumn(config.ConflictColumn, columnMap)
+	commentCol := fs.findColumn(config.CommentColumn, columnMap)
 
 	if idCol == -1 {
 		return nil, fmt.Errorf("%w: required ID column '%s' not found", ErrCSVFormat, config.IDColumn)
@@ -167,7 +168,7 @@ func (fs *FileStorage) parseCSVFromReader(reader io.Reader, config CSVConfig, el
 			continue
 		}
 
-		proposal, err := fs.parseProposalFromRow(row, rowIdx+1, headers, idCol, titleCol, speakerCol, abstractCol, scoreCol, conflictCol, eloConfig)
+		proposal, err := fs.parseProposalFromRow(row, rowIdx+1, headers, idCol, titleCol, speakerCol, abstractCol, scoreCol, conflictCol, commentCol, eloConfig)
 		if err != nil {
 			if csvErr, ok := err.(CSVParseError); ok {
 				parseErrors = append(parseErrors, csvErr)
@@ -193,6 +194,7 @@ func (fs *FileStorage) parseCSVFromReader(reader io.Reader, config CSVConfig, el
 		strings.ToLower(config.AbstractColumn): true,
 		strings.ToLower(config.ScoreColumn):    true,
 		strings.ToLower(config.ConflictColumn): true,
+		strings.ToLower(config.CommentColumn):  true,
 	}
 
 	for _, header := range headers {
@@ -242,7 +244,7 @@ func (fs *FileStorage) isEmptyRow(row []string) bool {
 
 // parseProposalFromRow creates a Proposal from a CSV row
 func (fs *FileStorage) parseProposalFromRow(row []string, rowNum int, headers []string,
-	idCol, titleCol, speakerCol, abstractCol, scoreCol, conflictCol int,
+	idCol, titleCol, speakerCol, abstractCol, scoreCol, conflictCol, commentCol int,
 	eloConfig *EloConfig) (*Proposal, error) {
 	// Validate row has enough columns
 	if err := fs.validateRowLength(row, rowNum, idCol, titleCol, speakerCol, abstractCol, scoreCol, conflictCol); err != nil {
@@ -264,6 +266,12 @@ func (fs *FileStorage) parseProposalFromRow(row []string, rowNum int, headers []
 	// Parse conflict tags
 	conflictTags := fs.parseConflictTags(row, conflictCol)
 
+	// Reviewer note from the comment column (optional)
+	var comment string
+	if commentCol >= 0 && commentCol < len(row) {
+		comment = strings.TrimSpace(row[commentCol])
+	}
+
 	// Preserve all metadata
 	metadata := fs.buildMetadata(row, headers)
 
@@ -277,6 +285,7 @@ func (fs *FileStorage) parseProposalFromRow(row []string, rowNum int, headers []
 		OriginalScore: originalScore,
 		Metadata:      metadata,
 		ConflictTags:  conflictTags,
+		Comment:       comment,
 		CreatedAt:     now,
 		UpdatedAt:     now,
 	}
@@ -429,6 +438,9 @@ func (fs *FileStorage) UpdateCSVScores(proposals []Proposal, filename string, co
 	// Update records with new scores
 	fs.updateRecordsWithScores(records, scoreMap, colInfo.headers, config, colInfo.scoreColIdx, colInfo.startRow)
 
+	// Write reviewer notes into the comment column
+	fs.updateRecordsWithNotes(records, proposals, colInfo.headers, config, colInfo.startRow)
+
 	// Write updated records back to file
 	return fs.writeCSVRecords(records, filename, config)
 }
@@ -521,6 +533,50 @@ func (fs *FileStorage) updateRecordsWithScores(records [][]string, scoreMap map[
 	}
 }
 
+// updateRecordsWithNotes writes the reviewer notes of the given proposals into the comment column.
+// The column is appended when the CSV has a header but no comment column and any proposal has a note.
+func (fs *FileStorage) updateRecordsWithNotes(records [][]string, proposals []Proposal, headers []string, config CSVConfig, startRow int) {
+	if !config.HasHeader || config.CommentColumn == "" {
+		return
+	}
+
+	notes := make(map[string]string, len(proposals))
+	noted := false
+	for _, proposal := range proposals {
+		notes[proposal.ID] = proposal.Comment
+		noted = noted || proposal.Comment != ""
+	}
+
+	commentColIdx := -1
+	for i, header := range headers {
+		if strings.EqualFold(strings.TrimSpace(header), config.CommentColumn) {
+			commentColIdx = i
+			break
+		}
+	}
+	if commentColIdx == -1 {
+		if !noted {
+			return
+		}
+		commentColIdx = len(headers)
+		records[0] = append(records[0], config.CommentColumn)
+		for rowIdx := startRow; rowIdx < len(records); rowIdx++ {
+			records[rowIdx] = append(records[rowIdx], "")
+		}
+	}
+
+	for rowIdx := startRow; rowIdx < len(records); rowIdx++ {
+		row := records[rowIdx]
+		if len(row) <= commentColIdx {
+			continue
+		}
+
+		if note, exists := notes[fs.findProposalID(row, headers, config)]; exists {
+			records[rowIdx][commentColIdx] = note
+		}
+	}
+}
+
 func (fs *FileStorage) findProposalID(row, headers []string, config CSVConfig) string {
 	var proposalID string
 	if config.HasHeader {
@@ -579,8 +635,20 @@ func (fs *FileStorage) SaveSession(session *Session, filename string) error {
 	// Extract current proposal scores for lightweight persistence
 	// Proposals will be reloaded from CSV when resuming
 	session.ProposalScores = make(map[string]float64, len(session.Proposals))
+	session.ProposalDeviations = nil
+	session.ProposalVolatilities = nil
 	for _, proposal := range session.Proposals {
 		session.ProposalScores[proposal.ID] = proposal.Score
+
+		// Rating uncertainty only exists for rating systems that track it
+		if proposal.Deviation > 0 {
+			if session.ProposalDeviations == nil {
+				session.ProposalDeviations = make(map[string]float64)
+				session.ProposalVolatilities = make(map[string]float64)
+			}
+			session.ProposalDeviations[proposal.ID] = proposal.Deviation
+			session.ProposalVolatilities[proposal.ID] = proposal.Volatility
+		}
 	}
 
 	// Ensure directory exists
@@ -588,6 +656,11 @@ func (fs *FileStorage) SaveSession(session *Session, filename string) error {
 		return fmt.Errorf("%w: cannot create session directory: %v", ErrJSONSerialization, err)
 	}
 
+	// Append new comparisons to the history log before the session snapshot
+	if err := fs.saveComparisonHistory(session, filename); err != nil {
+		return err
+	}
+
 	// Choose write strategy based on configuration
 	if fs.atomicWrites {
 		return fs.saveSessionAtomic(session, filename)
@@ -595,6 +668,140 @@ func (fs *FileStorage) SaveSession(session *Session, filename string) error {
 	return fs.saveSessionDirect(session, filename)
 }
 
+// HistoryFilename returns the path of the comparison history log that belongs to a session file
+func HistoryFilename(sessionFile string) string {
+	return strings.TrimSuffix(sessionFile, filepath.Ext(sessionFile)) + ".history.jsonl"
+}
+
+// saveComparisonHistory writes completed comparisons to the append-only history log.
+// Only comparisons not yet in the log are appended; the log is rewritten from scratch
+// when it belongs to another file or the in-memory history no longer extends it (e.g. after undo).
+func (fs *FileStorage) saveComparisonHistory(session *Session, filename string) error {
+	historyFile := HistoryFilename(filename)
+	comparisons := session.CompletedComparisons
+	cursor := session.history
+
+	appendOnly := cursor.file == historyFile && cursor.count <= len(comparisons) &&
+		(cursor.count == 0 || comparisons[cursor.count-1].ID == cursor.lastID)
+
+	switch {
+	case appendOnly && cursor.count == len(comparisons):
+		return nil // Nothing new to write
+	case appendOnly:
+		if err := appendComparisonHistory(historyFile, comparisons[cursor.count:]); err != nil {
+			return err
+		}
+	case len(comparisons) == 0:
+		// No history to keep - drop any stale log instead of leaving an empty file
+		if err := os.Remove(historyFile); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("%w: cannot remove history log: %v", ErrStorageOperation, err)
+		}
+		session.history = historyCursor{file: historyFile}
+		return nil
+	default:
+		if err := rewriteComparisonHistory(historyFile, comparisons); err != nil {
+			return err
+		}
+	}
+
+	session.history = historyCursor{
+		file:   historyFile,
+		count:  len(comparisons),
+		lastID: comparisons[len(comparisons)-1].ID,
+	}
+	return nil
+}
+
+// appendComparisonHistory appends comparisons to the end of a history log
+func appendComparisonHistory(historyFile string, comparisons []Comparison) error {
+	file, err := os.OpenFile(historyFile, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return fmt.Errorf("%w: cannot open history log: %v", ErrStorageOperation, err)
+	}
+	defer func() { _ = file.Close() }()
+
+	if err := writeComparisonHistory(file, comparisons); err != nil {
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		return fmt.Errorf("%w: failed to sync history log: %v", ErrStorageOperation, err)
+	}
+	return nil
+}
+
+// rewriteComparisonHistory replaces a history log through a temporary file and a rename,
+// so an interrupted rewrite leaves the previous log intact
+func rewriteComparisonHistory(historyFile string, comparisons []Comparison) error {
+	tempFile := historyFile + ".tmp"
+
+	file, err := os.Create(tempFile)
+	if err != nil {
+		return fmt.Errorf("%w: cannot create temp history log: %v", ErrAtomicWrite, err)
+	}
+
+	if err := writeComparisonHistory(file, comparisons); err != nil {
+		_ = file.Close()
+		_ = os.Remove(tempFile)
+		return err
+	}
+
+	if err := file.Sync(); err != nil {
+		_ = file.Close()
+		_ = os.Remove(tempFile)
+		return fmt.Errorf("%w: failed to sync history log: %v", ErrAtomicWrite, err)
+	}
+
+	_ = file.Close()
+
+	if err := os.Rename(tempFile, historyFile); err != nil {
+		_ = os.Remove(tempFile)
+		return fmt.Errorf("%w: atomic rename of history log failed: %v", ErrAtomicWrite, err)
+	}
+	return nil
+}
+
+// writeComparisonHistory encodes comparisons as one JSON record per line,
+// which keeps appends cheap and the log readable
+func writeComparisonHistory(w io.Writer, comparisons []Comparison) error {
+	encoder := json.NewEncoder(w)
+	for i := range comparisons {
+		if err := encoder.Encode(&comparisons[i]); err != nil {
+			return fmt.Errorf("%w: failed to encode comparison %s: %v", ErrJSONSerialization, comparisons[i].ID, err)
+		}
+	}
+	return nil
+}
+
+// loadComparisonHistory reads all comparisons from a history log.
+// A missing log yields an empty history; a truncated trailing record (interrupted append)
+// is dropped and reported through the complete flag so the log gets rewritten on next save.
+func loadComparisonHistory(historyFile string) (comparisons []Comparison, complete bool, err error) {
+	file, err := os.Open(historyFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []Comparison{}, true, nil
+		}
+		return nil, false, fmt.Errorf("%w: cannot open history log: %v", ErrStorageOperation, err)
+	}
+	defer func() { _ = file.Close() }()
+
+	comparisons = make([]Comparison, 0)
+	decoder := json.NewDecoder(file)
+	for {
+		var comparison Comparison
+		if err := decoder.Decode(&comparison); err != nil {
+			if err == io.EOF {
+				return comparisons, true, nil
+			}
+			if errors.Is(err, io.ErrUnexpectedEOF) {
+				return comparisons, false, nil
+			}
+			return nil, false, fmt.Errorf("%w: corrupted history log: %v", ErrCorruptedFile, err)
+		}
+		comparisons = append(comparisons, comparison)
+	}
+}
+
 // saveSessionAtomic performs an atomic write using temporary file + rename
 func (fs *FileStorage) saveSessionAtomic(session *Session, filename string) error {
 	tempFile := filename + ".tmp"
@@ -699,6 +906,8 @@ func (fs *FileStorage) loadSessionFromFile(filename string) (*Session, error) {
 			if savedScore, exists := session.ProposalScores[session.Proposals[i].ID]; exists {
 				session.Proposals[i].Score = savedScore
 			}
+			session.Proposals[i].Deviation = session.ProposalDeviations[session.Proposals[i].ID]
+			session.Proposals[i].Volatility = session.ProposalVolatilities[session.Proposals[i].ID]
 		}
 	}
 
@@ -708,6 +917,10 @@ func (fs *FileStorage) loadSessionFromFile(filename string) (*Session, error) {
 		session.ProposalIndex[proposal.ID] = i
 	}
 
+	// Notes edited in the session take precedence over the CSV comment column
+	session.restoreNotes()
+	session.restoreLabels()
+
 	// Initialize or update ConvergenceMetrics based on loaded comparison counts
 	if session.ConvergenceMetrics == nil {
 		// Create new metrics if none exist (old session format)
@@ -731,6 +944,26 @@ func (fs *FileStorage) loadSessionFromFile(filename string) (*Session, error) {
 		session.ComparisonCounts = make(map[string]int)
 	}
 
+	// Restore comparison history from the sidecar log (absent for old sessions)
+	historyFile := HistoryFilename(filename)
+	comparisons, complete, err := loadComparisonHistory(historyFile)
+	if err != nil {
+		return nil, err
+	}
+	session.CompletedComparisons = comparisons
+	if complete && len(comparisons) > 0 {
+		session.history = historyCursor{
+			file:   historyFile,
+			count:  len(comparisons),
+			lastID: comparisons[len(comparisons)-1].ID,
+		}
+	} else if complete {
+		session.history = historyCursor{file: historyFile}
+	}
+
+	// Re-evaluate convergence against the restored history
+	session.calculateConvergenceMetrics()
+
 	// Set storage directory for loaded session
 	sessionDir := filepath.Dir(filename)
 	session.storageDirectory = sessionDir
@@ -83,6 +83,20 @@ PROP002,"Microservices","John Smith","tag3"`,
 				assert.Equal(t, []string{"tag3"}, proposals[1].ConflictTags)
 			},
 		},
+		{
+			name: "CSV with comment column",
+			csvContent: `id,title,speaker,comments
+PROP001,"Go Testing","Jane Doe"," Strong speaker "
+PROP002,"Microservices","John Smith",""`,
+			config:      DefaultCSVConfig(),
+			wantCount:   2,
+			wantErrors:  0,
+			wantSkipped: 0,
+			checkFirst: func(t *testing.T, proposals []Proposal) {
+				assert.Equal(t, "Strong speaker", proposals[0].Comment)
+				assert.Empty(t, proposals[1].Comment)
+			},
+		},
 		{
 			name: "CSV with empty rows and missing data",
 			csvContent: `id,title,speaker
@@ -306,6 +320,29 @@ PROP001,Test Proposal,Test Speaker`
 		assert.Equal(t, csvPath, loadedSession.InputCSVPath)
 	})
 
+	t.Run("restores rating deviation", func(t *testing.T) {
+		sessionPath := filepath.Join(tempDir, "glicko_session.json")
+		glickoSession := &Session{
+			Name:         "glicko-session",
+			InputCSVPath: csvPath,
+			CreatedAt:    time.Now(),
+			UpdatedAt:    time.Now(),
+			Config:       DefaultSessionConfig(),
+			Proposals:    []Proposal{{ID: "PROP001", Title: "Test Proposal", Score: 1550, Deviation: 120, Volatility: 0.059}},
+		}
+		require.NoError(t, fs.SaveSession(glickoSession, sessionPath))
+
+		loadedSession, err := fs.LoadSession(sessionPath)
+		require.NoError(t, err)
+		defer func() { _ = loadedSession.Close() }()
+
+		proposal, err := loadedSession.GetProposalByID("PROP001")
+		require.NoError(t, err)
+		assert.Equal(t, 1550.0, proposal.Score)
+		assert.Equal(t, 120.0, proposal.Deviation)
+		assert.Equal(t, 0.059, proposal.Volatility)
+	})
+
 	t.Run("file not found", func(t *testing.T) {
 		_, err := fs.LoadSession("nonexistent.json")
 		assert.Error(t, err)
@@ -323,6 +360,133 @@ PROP001,Test Proposal,Test Speaker`
 	})
 }
 
+func TestFileStorage_ComparisonHistory(t *testing.T) {
+	tempDir := t.TempDir()
+	fs := NewFileStorage()
+
+	csvPath := filepath.Join(tempDir, "test_proposals.csv")
+	csvContent := `id,title,speaker
+PROP001,First Proposal,Speaker A
+PROP002,Second Proposal,Speaker B`
+	err := os.WriteFile(csvPath, []byte(csvContent), 0644)
+	require.NoError(t, err)
+
+	newComparison := func(id string) Comparison {
+		return Comparison{
+			ID:          id,
+			SessionName: "history-session",
+			ProposalIDs: []string{"PROP001", "PROP002"},
+			WinnerID:    "PROP001",
+			Rankings:    []string{"PROP001", "PROP002"},
+			Method:      MethodPairwise,
+			Timestamp:   time.Now().UTC().Truncate(time.Second),
+			Duration:    3 * time.Second,
+			EloUpdates: []EloUpdate{
+				{ID: id + "_PROP001", ComparisonID: id, ProposalID: "PROP001", OldRating: 1500, NewRating: 1516, RatingDelta: 16, KFactor: 32},
+				{ID: id + "_PROP002", ComparisonID: id, ProposalID: "PROP002", OldRating: 1500, NewRating: 1484, RatingDelta: -16, KFactor: 32},
+			},
+		}
+	}
+
+	session := &Session{
+		Name:         "history-session",
+		InputCSVPath: csvPath,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+		Config:       DefaultSessionConfig(),
+	}
+	sessionPath := filepath.Join(tempDir, "history-session.json")
+	historyPath := HistoryFilename(sessionPath)
+
+	t.Run("no log without comparisons", func(t *testing.T) {
+		require.NoError(t, fs.SaveSession(session, sessionPath))
+		_, err := os.Stat(historyPath)
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("round trip preserves full records", func(t *testing.T) {
+		session.CompletedComparisons = []Comparison{newComparison("comp1"), newComparison("comp2")}
+		require.NoError(t, fs.SaveSession(session, sessionPath))
+
+		loaded, err := fs.LoadSession(sessionPath)
+		require.NoError(t, err)
+		require.Len(t, loaded.CompletedComparisons, 2)
+		assert.Equal(t, session.CompletedComparisons[0].EloUpdates, loaded.CompletedComparisons[0].EloUpdates)
+		assert.Equal(t, session.CompletedComparisons[1].Rankings, loaded.CompletedComparisons[1].Rankings)
+		assert.Equal(t, 3*time.Second, loaded.CompletedComparisons[1].Duration)
+	})
+
+	t.Run("appends only new comparisons", func(t *testing.T) {
+		session.CompletedComparisons = append(session.CompletedComparisons, newComparison("comp3"))
+		require.NoError(t, fs.SaveSession(session, sessionPath))
+		require.NoError(t, fs.SaveSession(session, sessionPath)) // No-op save must not duplicate records
+
+		comparisons, complete, err := loadComparisonHistory(historyPath)
+		require.NoError(t, err)
+		assert.True(t, complete)
+		require.Len(t, comparisons, 3)
+		assert.Equal(t, "comp3", comparisons[2].ID)
+	})
+
+	t.Run("rewrites log when history is rewound", func(t *testing.T) {
+		session.CompletedComparisons = []Comparison{newComparison("comp1"), newComparison("comp4")}
+		require.NoError(t, fs.SaveSession(session, sessionPath))
+
+		comparisons, _, err := loadComparisonHistory(historyPath)
+		require.NoError(t, err)
+		require.Len(t, comparisons, 2)
+		assert.Equal(t, "comp4", comparisons[1].ID)
+		_, err = os.Stat(historyPath + ".tmp")
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("failed rewrite keeps the previous log", func(t *testing.T) {
+		// A directory in place of the temp file makes the rewrite fail before the rename
+		require.NoError(t, os.Mkdir(historyPath+".tmp", 0755))
+		defer func() { _ = os.Remove(historyPath + ".tmp") }()
+
+		rewound := &Session{
+			Name:                 session.Name,
+			InputCSVPath:         csvPath,
+			Config:               session.Config,
+			CompletedComparisons: []Comparison{newComparison("comp6")},
+			history:              session.history,
+		}
+		assert.ErrorIs(t, fs.SaveSession(rewound, sessionPath), ErrAtomicWrite)
+
+		comparisons, complete, err := loadComparisonHistory(historyPath)
+		require.NoError(t, err)
+		assert.True(t, complete)
+		require.Len(t, comparisons, 2)
+		assert.Equal(t, "comp4", comparisons[1].ID)
+	})
+
+	t.Run("truncated trailing record is dropped", func(t *testing.T) {
+		file, err := os.OpenFile(historyPath, os.O_WRONLY|os.O_APPEND, 0644)
+		require.NoError(t, err)
+		_, err = file.WriteString(`{"id":"comp5","session_name":`)
+		require.NoError(t, err)
+		require.NoError(t, file.Close())
+
+		loaded, err := fs.LoadSession(sessionPath)
+		require.NoError(t, err)
+		assert.Len(t, loaded.CompletedComparisons, 2)
+
+		// Next save repairs the log
+		require.NoError(t, fs.SaveSession(loaded, sessionPath))
+		comparisons, complete, err := loadComparisonHistory(historyPath)
+		require.NoError(t, err)
+		assert.True(t, complete)
+		assert.Len(t, comparisons, 2)
+	})
+
+	t.Run("corrupted log", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(historyPath, []byte("not json\n"), 0644))
+		_, err := fs.LoadSession(sessionPath)
+		assert.ErrorIs(t, err, ErrCorruptedFile)
+	})
+}
+
 func TestFileStorage_ConcurrentOperations(t *testing.T) {
 	tempDir := t.TempDir()
 	fs := NewFileStorage()
@@ -0,0 +1,123 @@
+// Package data provides the triage pre-pass of large sessions.
+// Before comparing, a reviewer can quickly bin each proposal as strong, maybe or weak.
+// The bucket seeds the starting rating of proposals that were not compared yet,
+// and weak proposals can be kept out of further matchups.
+package data
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Bucket is the triage decision of a proposal
+type Bucket string
+
+// Triage buckets
+const (
+	BucketNone   Bucket = ""       // Not triaged
+	BucketStrong Bucket = "strong" // Clearly worth comparing near the top
+	BucketMaybe  Bucket = "maybe"  // Undecided
+	BucketWeak   Bucket = "weak"   // Clearly weak
+)
+
+// ErrInvalidBucket is returned for an unknown triage bucket
+var ErrInvalidBucket = errors.New("invalid triage bucket")
+
+// ParseBucket returns the bucket with the given name ("" clears the bucket)
+func ParseBucket(name string) (Bucket, error) {
+	switch bucket := Bucket(name); bucket {
+	case BucketNone, BucketStrong, BucketMaybe, BucketWeak:
+		return bucket, nil
+	default:
+		return BucketNone, fmt.Errorf("%w: '%s' must be strong, maybe or weak", ErrInvalidBucket, name)
+	}
+}
+
+// BucketScore returns the score on the output scale that seeds a bucket's rating:
+// three quarters of the scale for strong, the middle for maybe and one quarter for weak
+func (e *EloConfig) BucketScore(bucket Bucket) float64 {
+	fraction := 0.5
+	switch bucket {
+	case BucketStrong:
+		fraction = 0.75
+	case BucketWeak:
+		fraction = 0.25
+	}
+	return e.OutputMin + fraction*(e.OutputMax-e.OutputMin)
+}
+
+// SetBucket records the triage bucket of a proposal (BucketNone clears it).
+// Proposals that were not compared yet start from the bucket's rating, or again from
+// their CSV score when the bucket is cleared; compared proposals keep their rating.
+func (s *Session) SetBucket(proposalID string, bucket Bucket) error {
+	if _, err := ParseBucket(string(bucket)); err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	idx, exists := s.ProposalIndex[proposalID]
+	if !exists {
+		return fmt.Errorf("proposal not found: %s", proposalID)
+	}
+
+	if bucket == BucketNone {
+		delete(s.Triage, proposalID)
+	} else {
+		if s.Triage == nil {
+			s.Triage = make(map[string]Bucket)
+		}
+		s.Triage[proposalID] = bucket
+	}
+
+	if s.ComparisonCounts[proposalID] == 0 {
+		proposal := &s.Proposals[idx]
+		switch {
+		case bucket != BucketNone:
+			proposal.Score = s.Config.Elo.ConvertCSVScoreToElo(s.Config.Elo.BucketScore(bucket))
+		case proposal.OriginalScore != nil:
+			proposal.Score = s.Config.Elo.ConvertCSVScoreToElo(*proposal.OriginalScore)
+		default:
+			proposal.Score = s.Config.Elo.InitialRating
+		}
+		proposal.UpdatedAt = time.Now()
+	}
+
+	s.UpdatedAt = time.Now()
+	return nil
+}
+
+// GetBucket returns the triage bucket of a proposal (BucketNone when not triaged)
+func (s *Session) GetBucket(proposalID string) Bucket {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.Triage[proposalID]
+}
+
+// GetTriage returns a copy of the triage buckets by proposal ID
+func (s *Session) GetTriage() map[string]Bucket {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	triage := make(map[string]Bucket, len(s.Triage))
+	for id, bucket := range s.Triage {
+		triage[id] = bucket
+	}
+	return triage
+}
+
+// SetExcludeWeak sets whether proposals triaged as weak are kept out of further matchups
+func (s *Session) SetExcludeWeak(exclude bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.ExcludeWeak = exclude
+}
+
+// ExcludesWeak reports whether proposals triaged as weak are kept out of further matchups
+func (s *Session) ExcludesWeak() bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.ExcludeWeak
+}
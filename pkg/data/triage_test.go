@@ -0,0 +1,116 @@
+package data
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBucket(t *testing.T) {
+	for _, name := range []string{"", "strong", "maybe", "weak"} {
+		bucket, err := ParseBucket(name)
+		require.NoError(t, err)
+		assert.Equal(t, Bucket(name), bucket)
+	}
+
+	_, err := ParseBucket("great")
+	assert.ErrorIs(t, err, ErrInvalidBucket)
+}
+
+func TestSessionTriage(t *testing.T) {
+	newTriageSession := func(t *testing.T) *Session {
+		session, err := NewSession("Triage Session", createTestProposals(), createTestConfig(), "test.csv")
+		require.NoError(t, err)
+		return session
+	}
+
+	score := func(t *testing.T, session *Session, id string) float64 {
+		proposal, err := session.GetProposalByID(id)
+		require.NoError(t, err)
+		return proposal.Score
+	}
+
+	t.Run("buckets seed the rating on the output scale", func(t *testing.T) {
+		session := newTriageSession(t)
+
+		require.NoError(t, session.SetBucket("prop1", BucketStrong))
+		require.NoError(t, session.SetBucket("prop2", BucketMaybe))
+		require.NoError(t, session.SetBucket("prop3", BucketWeak))
+
+		assert.Equal(t, 2250.0, score(t, session, "prop1"))
+		assert.Equal(t, 1500.0, score(t, session, "prop2"))
+		assert.Equal(t, 750.0, score(t, session, "prop3"))
+		assert.Equal(t, BucketStrong, session.GetBucket("prop1"))
+		assert.Equal(t, map[string]Bucket{"prop1": BucketStrong, "prop2": BucketMaybe, "prop3": BucketWeak}, session.GetTriage())
+	})
+
+	t.Run("clearing restores the CSV score", func(t *testing.T) {
+		session := newTriageSession(t)
+		original := 8.0
+		session.Proposals[0].OriginalScore = &original
+
+		require.NoError(t, session.SetBucket("prop1", BucketWeak))
+		require.NoError(t, session.SetBucket("prop1", BucketNone))
+		require.NoError(t, session.SetBucket("prop2", BucketStrong))
+		require.NoError(t, session.SetBucket("prop2", BucketNone))
+
+		assert.Equal(t, 2400.0, score(t, session, "prop1"))
+		assert.Equal(t, 1500.0, score(t, session, "prop2"))
+		assert.Equal(t, BucketNone, session.GetBucket("prop1"))
+		assert.Empty(t, session.GetTriage())
+	})
+
+	t.Run("compared proposals keep their rating", func(t *testing.T) {
+		session := newTriageSession(t)
+		require.NoError(t, session.RecordComparison(createUndoTestComparison("c1", "prop1", "prop2", 1500, 1500)))
+		rating := score(t, session, "prop1")
+
+		require.NoError(t, session.SetBucket("prop1", BucketWeak))
+
+		assert.Equal(t, rating, score(t, session, "prop1"))
+		assert.Equal(t, BucketWeak, session.GetBucket("prop1"))
+	})
+
+	t.Run("rejects unknown buckets and proposals", func(t *testing.T) {
+		session := newTriageSession(t)
+
+		assert.ErrorIs(t, session.SetBucket("prop1", Bucket("great")), ErrInvalidBucket)
+		assert.Error(t, session.SetBucket("missing", BucketStrong))
+		assert.Empty(t, session.GetTriage())
+	})
+
+	t.Run("weak proposals can be excluded from matchups", func(t *testing.T) {
+		session := newTriageSession(t)
+		require.NoError(t, session.SetBucket("prop2", BucketWeak))
+		assert.Len(t, session.GetReviewableProposals(), 3)
+
+		session.SetExcludeWeak(true)
+		assert.True(t, session.ExcludesWeak())
+
+		ids := make([]string, 0)
+		for _, proposal := range session.GetReviewableProposals() {
+			ids = append(ids, proposal.ID)
+		}
+		assert.Equal(t, []string{"prop1", "prop3"}, ids)
+	})
+
+	t.Run("triage is persisted with the session", func(t *testing.T) {
+		tempDir := t.TempDir()
+		session, err := NewSession("Triage Session", createTestProposals(), createTestConfig(), createTempCSV(t, tempDir))
+		require.NoError(t, err)
+		require.NoError(t, session.SetBucket("prop3", BucketStrong))
+		session.SetExcludeWeak(true)
+
+		storage := &FileStorage{}
+		sessionFile := filepath.Join(tempDir, "triage.json")
+		require.NoError(t, storage.SaveSession(session, sessionFile))
+
+		loaded, err := storage.LoadSession(sessionFile)
+		require.NoError(t, err)
+		assert.Equal(t, BucketStrong, loaded.GetBucket("prop3"))
+		assert.True(t, loaded.ExcludesWeak())
+		assert.Equal(t, 2250.0, score(t, loaded, "prop3"))
+	})
+}
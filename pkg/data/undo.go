@@ -0,0 +1,268 @@
+// Package data provides session-level undo and redo of completed comparisons.
+// Undo reverts the Elo updates recorded on a comparison and rolls back the
+// comparison counters, so a misclick never permanently changes the ranking.
+package data
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Error types for undo/redo operations
+var (
+	ErrNothingToUndo = errors.New("no comparison to undo")
+	ErrNothingToRedo = errors.New("no comparison to redo")
+)
+
+// RecordComparison adds an externally evaluated comparison to the session.
+// The comparison's Elo updates are applied to the proposals, comparison counters
+// are incremented and the redo stack is cleared.
+func (s *Session) RecordComparison(comparison Comparison) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if len(comparison.ProposalIDs) < 2 {
+		return fmt.Errorf("%w: comparison requires at least 2 proposals", ErrInvalidComparison)
+	}
+
+	// Verify all proposals exist before changing any state
+	for _, id := range comparison.ProposalIDs {
+		if _, exists := s.ProposalIndex[id]; !exists {
+			return fmt.Errorf("%w: proposal not found: %s", ErrInvalidComparison, id)
+		}
+	}
+	for _, update := range comparison.EloUpdates {
+		if _, exists := s.ProposalIndex[update.ProposalID]; !exists {
+			return fmt.Errorf("%w: rating update for unknown proposal: %s", ErrInvalidComparison, update.ProposalID)
+		}
+	}
+
+	if comparison.ID == "" {
+		comparisonID, err := generateComparisonID()
+		if err != nil {
+			return fmt.Errorf("failed to generate comparison ID: %w", err)
+		}
+		comparison.ID = comparisonID
+	}
+	if comparison.SessionName == "" {
+		comparison.SessionName = s.Name
+	}
+	if comparison.Reviewer == "" {
+		comparison.Reviewer = s.reviewer
+	}
+	if comparison.Timestamp.IsZero() {
+		comparison.Timestamp = time.Now()
+	}
+
+	if s.Status == StatusCreated {
+		s.Status = StatusActive
+	}
+
+	s.applyComparison(comparison)
+	s.redoStack = nil
+
+	return nil
+}
+
+// UndoLastComparison reverts the most recent comparison and returns it so the
+// matchup can be presented again. The comparison is kept on the redo stack.
+func (s *Session) UndoLastComparison() (*Comparison, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if len(s.CompletedComparisons) == 0 {
+		return nil, ErrNothingToUndo
+	}
+
+	last := len(s.CompletedComparisons) - 1
+	comparison := s.CompletedComparisons[last]
+	s.CompletedComparisons = s.CompletedComparisons[:last]
+
+	// Restore ratings in reverse order so repeated updates of one proposal unwind correctly
+	for i := len(comparison.EloUpdates) - 1; i >= 0; i-- {
+		update := comparison.EloUpdates[i]
+		if idx, exists := s.ProposalIndex[update.ProposalID]; exists {
+			s.Proposals[idx].Score = update.OldRating
+			s.Proposals[idx].Deviation = update.OldDeviation
+			s.Proposals[idx].Volatility = update.OldVolatility
+			s.Proposals[idx].UpdatedAt = time.Now()
+		}
+	}
+
+	s.countComparison(comparison, -1)
+	if !comparison.Skipped {
+		for i := 0; i < len(comparison.ProposalIDs); i++ {
+			for j := i + 1; j < len(comparison.ProposalIDs); j++ {
+				s.unrecordMatchupInternal(comparison.ProposalIDs[i], comparison.ProposalIDs[j])
+			}
+		}
+	}
+
+	// The recent window is capped, so rebuild it from the comparisons still in the history
+	if s.ConvergenceMetrics != nil {
+		s.ConvergenceMetrics.RecentRatingChanges = s.recentRatingChanges()
+	}
+
+	s.redoStack = append(s.redoStack, comparison)
+	s.CurrentComparison = nil
+	s.updateConvergenceMetrics()
+	s.updateRatingBins()
+	s.UpdatedAt = time.Now()
+
+	return &comparison, nil
+}
+
+// RedoComparison re-applies the most recently undone comparison and returns it
+func (s *Session) RedoComparison() (*Comparison, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if len(s.redoStack) == 0 {
+		return nil, ErrNothingToRedo
+	}
+
+	last := len(s.redoStack) - 1
+	comparison := s.redoStack[last]
+	s.redoStack = s.redoStack[:last]
+
+	s.applyComparison(comparison)
+
+	return &comparison, nil
+}
+
+// CanUndo reports whether there is a completed comparison to undo
+func (s *Session) CanUndo() bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return len(s.CompletedComparisons) > 0
+}
+
+// CanRedo reports whether there is an undone comparison to redo
+func (s *Session) CanRedo() bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return len(s.redoStack) > 0
+}
+
+// applyComparison appends a comparison and applies its rating updates and counters (internal, no locking)
+func (s *Session) applyComparison(comparison Comparison) {
+	s.CompletedComparisons = append(s.CompletedComparisons, comparison)
+
+	ratingUpdates := make([]EloRatingUpdate, 0, len(comparison.EloUpdates))
+	for _, update := range comparison.EloUpdates {
+		if idx, exists := s.ProposalIndex[update.ProposalID]; exists {
+			s.Proposals[idx].Score = update.NewRating
+			if update.NewDeviation > 0 {
+				s.Proposals[idx].Deviation = update.NewDeviation
+				s.Proposals[idx].Volatility = update.NewVolatility
+			}
+			s.Proposals[idx].UpdatedAt = time.Now()
+		}
+		if s.ConvergenceMetrics != nil {
+			s.ConvergenceMetrics.RecentRatingChanges = append(s.ConvergenceMetrics.RecentRatingChanges, update.RatingDelta)
+		}
+		ratingUpdates = append(ratingUpdates, EloRatingUpdate{
+			ProposalID: update.ProposalID,
+			OldRating:  update.OldRating,
+			NewRating:  update.NewRating,
+			Delta:      update.RatingDelta,
+			KFactor:    update.KFactor,
+		})
+	}
+
+	// Keep the recent window bounded
+	if s.ConvergenceMetrics != nil && len(s.ConvergenceMetrics.RecentRatingChanges) > recentRatingWindow {
+		changes := s.ConvergenceMetrics.RecentRatingChanges
+		s.ConvergenceMetrics.RecentRatingChanges = changes[len(changes)-recentRatingWindow:]
+	}
+
+	s.countComparison(comparison, 1)
+	if !comparison.Skipped {
+		for i := 0; i < len(comparison.ProposalIDs); i++ {
+			for j := i + 1; j < len(comparison.ProposalIDs); j++ {
+				proposalA, proposalB := comparison.ProposalIDs[i], comparison.ProposalIDs[j]
+				s.recordMatchupInternal(proposalA, proposalB, s.calculateInformationGain(proposalA, proposalB, ratingUpdates))
+			}
+		}
+	}
+
+	s.updateConvergenceMetrics()
+	s.updateRatingBins()
+	s.UpdatedAt = time.Now()
+}
+
+// recentRatingWindow is the number of latest rating changes kept for the convergence metrics
+const recentRatingWindow = 10
+
+// recentRatingChanges returns the latest rating changes of the completed comparisons,
+// oldest first (internal, no locking)
+func (s *Session) recentRatingChanges() []float64 {
+	changes := make([]float64, 0, recentRatingWindow)
+	for i := len(s.CompletedComparisons) - 1; i >= 0 && len(changes) < recentRatingWindow; i-- {
+		updates := s.CompletedComparisons[i].EloUpdates
+		for j := len(updates) - 1; j >= 0 && len(changes) < recentRatingWindow; j-- {
+			changes = append(changes, updates[j].RatingDelta)
+		}
+	}
+	slices.Reverse(changes)
+	return changes
+}
+
+// countComparison adjusts total, per-proposal and per-reviewer comparison counters by delta (internal, no locking)
+func (s *Session) countComparison(comparison Comparison, delta int) {
+	if comparison.Skipped {
+		return // Skipped comparisons do not contribute to progress or confidence
+	}
+
+	s.TotalComparisons = max(s.TotalComparisons+delta, 0)
+
+	if s.ComparisonCounts == nil {
+		s.ComparisonCounts = make(map[string]int)
+	}
+	for _, id := range comparison.ProposalIDs {
+		count := s.ComparisonCounts[id] + delta
+		if count <= 0 {
+			delete(s.ComparisonCounts, id)
+			continue
+		}
+		s.ComparisonCounts[id] = count
+	}
+
+	if comparison.Reviewer == "" {
+		return // Unattributed comparisons have no reviewer counter
+	}
+	if s.ReviewerCounts == nil {
+		s.ReviewerCounts = make(map[string]int)
+	}
+	if count := s.ReviewerCounts[comparison.Reviewer] + delta; count > 0 {
+		s.ReviewerCounts[comparison.Reviewer] = count
+	} else {
+		delete(s.ReviewerCounts, comparison.Reviewer)
+	}
+}
+
+// unrecordMatchupInternal reverts the latest matchup record for a pair (internal, no locking)
+func (s *Session) unrecordMatchupInternal(proposalA, proposalB string) {
+	// Same ordering as recordMatchupInternal
+	if proposalA > proposalB {
+		proposalA, proposalB = proposalB, proposalA
+	}
+
+	for i := range s.MatchupHistory {
+		matchup := &s.MatchupHistory[i]
+		if matchup.ProposalA != proposalA || matchup.ProposalB != proposalB {
+			continue
+		}
+
+		matchup.ComparisonCount--
+		if n := len(matchup.RatingDifferenceHistory); n > 0 {
+			matchup.RatingDifferenceHistory = matchup.RatingDifferenceHistory[:n-1]
+		}
+		if matchup.ComparisonCount <= 0 {
+			s.MatchupHistory = append(s.MatchupHistory[:i], s.MatchupHistory[i+1:]...)
+		}
+		return
+	}
+}
@@ -0,0 +1,195 @@
+package data
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createUndoTestComparison(id, winner, loser string, winnerOld, loserOld float64) Comparison {
+	return Comparison{
+		ID:          id,
+		ProposalIDs: []string{winner, loser},
+		WinnerID:    winner,
+		Method:      MethodPairwise,
+		EloUpdates: []EloUpdate{
+			{ID: id + "_w", ComparisonID: id, ProposalID: winner, OldRating: winnerOld, NewRating: winnerOld + 16, RatingDelta: 16, KFactor: 32},
+			{ID: id + "_l", ComparisonID: id, ProposalID: loser, OldRating: loserOld, NewRating: loserOld - 16, RatingDelta: -16, KFactor: 32},
+		},
+	}
+}
+
+func TestSessionUndoRedo(t *testing.T) {
+	newUndoSession := func(t *testing.T) *Session {
+		session, err := NewSession("Undo Session", createTestProposals(), createTestConfig(), "test.csv")
+		require.NoError(t, err)
+		return session
+	}
+
+	score := func(t *testing.T, session *Session, id string) float64 {
+		proposal, err := session.GetProposalByID(id)
+		require.NoError(t, err)
+		return proposal.Score
+	}
+
+	t.Run("record applies updates and counters", func(t *testing.T) {
+		session := newUndoSession(t)
+
+		require.NoError(t, session.RecordComparison(createUndoTestComparison("c1", "prop1", "prop2", 1500, 1500)))
+
+		assert.Equal(t, 1516.0, score(t, session, "prop1"))
+		assert.Equal(t, 1484.0, score(t, session, "prop2"))
+		assert.Equal(t, 1, session.TotalComparisons)
+		assert.Equal(t, 1, session.ComparisonCounts["prop1"])
+		assert.Equal(t, 1, session.ComparisonCounts["prop2"])
+		assert.Len(t, session.GetMatchupHistory(), 1)
+		assert.True(t, session.CanUndo())
+		assert.False(t, session.CanRedo())
+	})
+
+	t.Run("record rejects unknown proposals", func(t *testing.T) {
+		session := newUndoSession(t)
+
+		err := session.RecordComparison(createUndoTestComparison("c1", "prop1", "missing", 1500, 1500))
+		assert.ErrorIs(t, err, ErrInvalidComparison)
+		assert.Equal(t, 0, session.TotalComparisons)
+		assert.Equal(t, 1500.0, score(t, session, "prop1"))
+	})
+
+	t.Run("undo reverts last N comparisons in order", func(t *testing.T) {
+		session := newUndoSession(t)
+
+		require.NoError(t, session.RecordComparison(createUndoTestComparison("c1", "prop1", "prop2", 1500, 1500)))
+		require.NoError(t, session.RecordComparison(createUndoTestComparison("c2", "prop2", "prop3", 1484, 1500)))
+
+		undone, err := session.UndoLastComparison()
+		require.NoError(t, err)
+		assert.Equal(t, "c2", undone.ID)
+		assert.Equal(t, []string{"prop2", "prop3"}, undone.ProposalIDs)
+		assert.Equal(t, 1484.0, score(t, session, "prop2"))
+		assert.Equal(t, 1500.0, score(t, session, "prop3"))
+		assert.Equal(t, 1, session.TotalComparisons)
+		assert.NotContains(t, session.ComparisonCounts, "prop3")
+
+		undone, err = session.UndoLastComparison()
+		require.NoError(t, err)
+		assert.Equal(t, "c1", undone.ID)
+		for _, id := range []string{"prop1", "prop2", "prop3"} {
+			assert.Equal(t, 1500.0, score(t, session, id))
+		}
+		assert.Equal(t, 0, session.TotalComparisons)
+		assert.Empty(t, session.ComparisonCounts)
+		assert.Empty(t, session.GetComparisonHistory())
+		assert.Empty(t, session.GetMatchupHistory())
+
+		_, err = session.UndoLastComparison()
+		assert.ErrorIs(t, err, ErrNothingToUndo)
+	})
+
+	t.Run("undo rebuilds recent rating changes past the window cap", func(t *testing.T) {
+		session := newUndoSession(t)
+
+		// Eight comparisons with distinct deltas produce 16 changes, more than the window keeps
+		rating := 1500.0
+		for i := 1; i <= 8; i++ {
+			comparison := createUndoTestComparison(fmt.Sprintf("c%d", i), "prop1", "prop2", rating, 3000-rating)
+			comparison.EloUpdates[0].RatingDelta = float64(i)
+			comparison.EloUpdates[1].RatingDelta = float64(-i)
+			require.NoError(t, session.RecordComparison(comparison))
+			rating += 16
+		}
+		require.Len(t, session.ConvergenceMetrics.RecentRatingChanges, 10)
+
+		for range 3 {
+			_, err := session.UndoLastComparison()
+			require.NoError(t, err)
+		}
+
+		expected := []float64{1, -1, 2, -2, 3, -3, 4, -4, 5, -5}
+		assert.Equal(t, expected, session.ConvergenceMetrics.RecentRatingChanges)
+
+		for range 3 {
+			_, err := session.UndoLastComparison()
+			require.NoError(t, err)
+		}
+		assert.Equal(t, []float64{1, -1, 2, -2}, session.ConvergenceMetrics.RecentRatingChanges)
+	})
+
+	t.Run("redo re-applies undone comparisons", func(t *testing.T) {
+		session := newUndoSession(t)
+
+		require.NoError(t, session.RecordComparison(createUndoTestComparison("c1", "prop1", "prop2", 1500, 1500)))
+		_, err := session.UndoLastComparison()
+		require.NoError(t, err)
+		assert.True(t, session.CanRedo())
+
+		redone, err := session.RedoComparison()
+		require.NoError(t, err)
+		assert.Equal(t, "c1", redone.ID)
+		assert.Equal(t, 1516.0, score(t, session, "prop1"))
+		assert.Equal(t, 1, session.TotalComparisons)
+		assert.Len(t, session.GetComparisonHistory(), 1)
+
+		_, err = session.RedoComparison()
+		assert.ErrorIs(t, err, ErrNothingToRedo)
+	})
+
+	t.Run("new comparison clears redo stack", func(t *testing.T) {
+		session := newUndoSession(t)
+
+		require.NoError(t, session.RecordComparison(createUndoTestComparison("c1", "prop1", "prop2", 1500, 1500)))
+		_, err := session.UndoLastComparison()
+		require.NoError(t, err)
+
+		require.NoError(t, session.RecordComparison(createUndoTestComparison("c2", "prop3", "prop1", 1500, 1500)))
+		assert.False(t, session.CanRedo())
+	})
+
+	t.Run("undo restores rating deviation", func(t *testing.T) {
+		session := newUndoSession(t)
+
+		comparison := createUndoTestComparison("c1", "prop1", "prop2", 1500, 1500)
+		for i := range comparison.EloUpdates {
+			comparison.EloUpdates[i].NewDeviation = 290
+			comparison.EloUpdates[i].NewVolatility = 0.06
+		}
+		require.NoError(t, session.RecordComparison(comparison))
+
+		proposal, err := session.GetProposalByID("prop1")
+		require.NoError(t, err)
+		assert.Equal(t, 290.0, proposal.Deviation)
+		assert.Equal(t, 0.06, proposal.Volatility)
+
+		_, err = session.UndoLastComparison()
+		require.NoError(t, err)
+		proposal, err = session.GetProposalByID("prop1")
+		require.NoError(t, err)
+		assert.Zero(t, proposal.Deviation)
+		assert.Zero(t, proposal.Volatility)
+
+		_, err = session.RedoComparison()
+		require.NoError(t, err)
+		proposal, err = session.GetProposalByID("prop1")
+		require.NoError(t, err)
+		assert.Equal(t, 290.0, proposal.Deviation)
+	})
+
+	t.Run("undo engine-processed comparison", func(t *testing.T) {
+		session := newUndoSession(t)
+
+		require.NoError(t, session.StartComparison([]string{"prop1", "prop2", "prop3"}, MethodTrio))
+		require.NoError(t, session.ProcessMultiProposalComparison([]string{"prop3", "prop1", "prop2"}, NewMockEloEngine()))
+		assert.Equal(t, 1, session.TotalComparisons)
+		assert.Equal(t, 1, session.ComparisonCounts["prop3"])
+
+		_, err := session.UndoLastComparison()
+		require.NoError(t, err)
+		for _, id := range []string{"prop1", "prop2", "prop3"} {
+			assert.Equal(t, 1500.0, score(t, session, id))
+		}
+		assert.Equal(t, 0, session.TotalComparisons)
+		assert.Empty(t, session.GetMatchupHistory())
+	})
+}
@@ -0,0 +1,278 @@
+package elo
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Bradley–Terry fit errors
+var (
+	ErrInvalidFitConfig = errors.New("invalid fit configuration")
+	ErrSingularFit      = errors.New("information matrix is singular")
+)
+
+// eloPerLogStrength converts a natural-log strength difference into Elo points
+const eloPerLogStrength = 400.0 / math.Ln10
+
+// FitConfig holds parameters of the Bradley–Terry / Plackett–Luce fit
+type FitConfig struct {
+	InitialRating float64 // Rating of an average proposal on the fitted scale
+	PriorWeight   float64 // Virtual win and loss of every proposal against an average one
+	MaxIterations int     // Upper bound on MM iterations
+	Tolerance     float64 // Convergence threshold on the largest log-strength change
+}
+
+// DefaultFitConfig returns the default fit parameters
+func DefaultFitConfig() FitConfig {
+	return FitConfig{
+		InitialRating: 1500.0,
+		PriorWeight:   1.0,
+		MaxIterations: 1000,
+		Tolerance:     1e-9,
+	}
+}
+
+// PriorStdError returns the standard error of a rating backed by the prior alone,
+// i.e. of a proposal without comparisons; fitted ratings only get more certain than that
+func (c FitConfig) PriorStdError() float64 {
+	return eloPerLogStrength * math.Sqrt(2/c.PriorWeight)
+}
+
+// FitRating is the fitted strength of a single proposal
+type FitRating struct {
+	ID          string  // Proposal identifier
+	Strength    float64 // Log-strength relative to an average proposal
+	Rating      float64 // Strength on the Elo scale (InitialRating + 400/ln10 * Strength)
+	StdError    float64 // Standard error of Rating
+	Comparisons int     // Number of outcomes the proposal took part in
+}
+
+// FitResult is the outcome of a Bradley–Terry / Plackett–Luce fit
+type FitResult struct {
+	Ratings       []FitRating // Fitted ratings in input order
+	Iterations    int         // MM iterations performed
+	Converged     bool        // Whether the tolerance was reached within MaxIterations
+	LogLikelihood float64     // Log-likelihood of the outcomes under the fitted strengths
+}
+
+// plStage is one choice of a Plackett–Luce ranking: the winner is picked from the remaining set
+type plStage struct {
+	winner int
+	set    []int
+	weight float64 // Share of the choice (below 1 when several proposals tie for it)
+}
+
+// FitPlackettLuce fits a Plackett–Luce model (Bradley–Terry for pairs) to comparison outcomes.
+// Each outcome ranks proposal IDs from best to worst, so pairwise and multi-way
+// comparisons are used as rankings directly rather than exploded into pairs. Unlike Elo
+// the result does not depend on the order of the outcomes.
+// Proposals tied at a position share that choice equally, so a pairwise draw counts
+// as half a win for each proposal. In a partial ranking the unordered proposals at the
+// last position were never chosen among, so they only appear in the sets of earlier choices.
+// Every proposal gets PriorWeight virtual wins and losses against an average proposal,
+// which keeps strengths of unbeaten or never-compared proposals finite.
+func FitPlackettLuce(ids []string, outcomes []Outcome, config FitConfig) (*FitResult, error) {
+	if config.PriorWeight <= 0 || config.MaxIterations <= 0 || config.Tolerance <= 0 {
+		return nil, fmt.Errorf("%w: prior weight, max iterations and tolerance must be positive", ErrInvalidFitConfig)
+	}
+
+	index := make(map[string]int, len(ids))
+	for i, id := range ids {
+		if _, exists := index[id]; exists {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicateProposal, id)
+		}
+		index[id] = i
+	}
+
+	n := len(ids)
+	wins := make([]float64, n)
+	comparisons := make([]int, n)
+	stages := make([]plStage, 0)
+
+	for o, outcome := range outcomes {
+		if len(outcome.Ranking) < 2 {
+			return nil, fmt.Errorf("outcome %d: %w", o, ErrTooFewProposals)
+		}
+		positions := outcome.Positions
+		if len(positions) == 0 {
+			positions = make([]int, len(outcome.Ranking))
+			for i := range positions {
+				positions[i] = i
+			}
+		}
+		if err := validatePositions(positions, len(outcome.Ranking)); err != nil {
+			return nil, fmt.Errorf("outcome %d: %w", o, err)
+		}
+
+		ranked := make([]int, len(outcome.Ranking))
+		seen := make(map[int]bool, len(outcome.Ranking))
+		for i, id := range outcome.Ranking {
+			idx, exists := index[id]
+			if !exists {
+				return nil, fmt.Errorf("%w: %s in outcome %d", ErrUnknownProposal, id, o)
+			}
+			if seen[idx] {
+				return nil, fmt.Errorf("outcome %d: %w: %s", o, ErrDuplicateProposal, id)
+			}
+			seen[idx] = true
+			ranked[i] = idx
+			comparisons[idx]++
+		}
+
+		// Each group of tied proposals is chosen from the remaining set;
+		// a single last remaining proposal is not a choice
+		last := positions[len(positions)-1]
+		for start := 0; start < len(ranked)-1; {
+			if outcome.Unordered && positions[start] == last {
+				break
+			}
+
+			end := start + 1
+			for end < len(ranked) && positions[end] == positions[start] {
+				end++
+			}
+			weight := 1.0 / float64(end-start)
+			for _, winner := range ranked[start:end] {
+				stages = append(stages, plStage{winner: winner, set: ranked[start:], weight: weight})
+				wins[winner] += weight
+			}
+			start = end
+		}
+	}
+
+	// Minorization-maximization (Hunter, 2004); the prior opponent has strength 1
+	strengths := make([]float64, n)
+	for i := range strengths {
+		strengths[i] = 1.0
+	}
+
+	result := &FitResult{}
+	denominators := make([]float64, n)
+	for result.Iterations < config.MaxIterations {
+		result.Iterations++
+
+		for i := range denominators {
+			denominators[i] = 2 * config.PriorWeight / (strengths[i] + 1)
+		}
+		for _, stage := range stages {
+			total := 0.0
+			for _, k := range stage.set {
+				total += strengths[k]
+			}
+			for _, k := range stage.set {
+				denominators[k] += stage.weight / total
+			}
+		}
+
+		maxChange := 0.0
+		for i := range strengths {
+			updated := (wins[i] + config.PriorWeight) / denominators[i]
+			maxChange = math.Max(maxChange, math.Abs(math.Log(updated)-math.Log(strengths[i])))
+			strengths[i] = updated
+		}
+
+		if maxChange < config.Tolerance {
+			result.Converged = true
+			break
+		}
+	}
+
+	covariance, err := fitCovariance(strengths, stages, config.PriorWeight)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Ratings = make([]FitRating, n)
+	for i, id := range ids {
+		strength := math.Log(strengths[i])
+		result.Ratings[i] = FitRating{
+			ID:          id,
+			Strength:    strength,
+			Rating:      config.InitialRating + eloPerLogStrength*strength,
+			StdError:    eloPerLogStrength * math.Sqrt(covariance[i][i]),
+			Comparisons: comparisons[i],
+		}
+	}
+
+	for _, stage := range stages {
+		total := 0.0
+		for _, k := range stage.set {
+			total += strengths[k]
+		}
+		result.LogLikelihood += stage.weight * math.Log(strengths[stage.winner]/total)
+	}
+
+	return result, nil
+}
+
+// fitCovariance inverts the Fisher information of the log-strengths
+func fitCovariance(strengths []float64, stages []plStage, priorWeight float64) ([][]float64, error) {
+	n := len(strengths)
+	information := make([][]float64, n)
+	for i := range information {
+		information[i] = make([]float64, n)
+		p := strengths[i] / (strengths[i] + 1)
+		information[i][i] = 2 * priorWeight * p * (1 - p)
+	}
+
+	for _, stage := range stages {
+		total := 0.0
+		for _, k := range stage.set {
+			total += strengths[k]
+		}
+		for _, a := range stage.set {
+			pa := strengths[a] / total
+			information[a][a] += stage.weight * pa
+			for _, b := range stage.set {
+				information[a][b] -= stage.weight * pa * strengths[b] / total
+			}
+		}
+	}
+
+	return invertMatrix(information)
+}
+
+// invertMatrix inverts a square matrix with Gauss-Jordan elimination and partial pivoting
+func invertMatrix(matrix [][]float64) ([][]float64, error) {
+	n := len(matrix)
+	work := make([][]float64, n)
+	for i := range matrix {
+		work[i] = make([]float64, 2*n)
+		copy(work[i], matrix[i])
+		work[i][n+i] = 1
+	}
+
+	for col := 0; col < n; col++ {
+		pivot := col
+		for row := col + 1; row < n; row++ {
+			if math.Abs(work[row][col]) > math.Abs(work[pivot][col]) {
+				pivot = row
+			}
+		}
+		if math.Abs(work[pivot][col]) < 1e-12 {
+			return nil, ErrSingularFit
+		}
+		work[col], work[pivot] = work[pivot], work[col]
+
+		scale := work[col][col]
+		for j := range work[col] {
+			work[col][j] /= scale
+		}
+		for row := 0; row < n; row++ {
+			if row == col || work[row][col] == 0 {
+				continue
+			}
+			factor := work[row][col]
+			for j := range work[row] {
+				work[row][j] -= factor * work[col][j]
+			}
+		}
+	}
+
+	inverse := make([][]float64, n)
+	for i := range work {
+		inverse[i] = work[i][n:]
+	}
+	return inverse, nil
+}
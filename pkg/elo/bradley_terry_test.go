@@ -0,0 +1,147 @@
+package elo
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFitPlackettLuce(t *testing.T) {
+	config := DefaultFitConfig()
+	ids := []string{"a", "b", "c", "d"}
+
+	t.Run("pairwise result is symmetric", func(t *testing.T) {
+		fit, err := FitPlackettLuce([]string{"a", "b"}, toOutcomes([][]string{{"a", "b"}}), config)
+		require.NoError(t, err)
+		require.True(t, fit.Converged)
+
+		a, b := fit.Ratings[0], fit.Ratings[1]
+		assert.Greater(t, a.Rating, b.Rating)
+		assert.InDelta(t, 1500.0, (a.Rating+b.Rating)/2, 1e-6)
+		assert.InDelta(t, a.StdError, b.StdError, 1e-6)
+		assert.Equal(t, 1, a.Comparisons)
+	})
+
+	t.Run("never-compared proposal keeps the prior standard error", func(t *testing.T) {
+		fit, err := FitPlackettLuce(ids, toOutcomes([][]string{{"a", "b"}, {"b", "c"}}), config)
+		require.NoError(t, err)
+
+		assert.InDelta(t, config.PriorStdError(), fit.Ratings[3].StdError, 1e-6)
+		assert.InDelta(t, 1500.0, fit.Ratings[3].Rating, 1e-6)
+		assert.Less(t, fit.Ratings[1].StdError, config.PriorStdError())
+	})
+
+	t.Run("result does not depend on outcome order", func(t *testing.T) {
+		outcomes := toOutcomes([][]string{{"a", "b"}, {"b", "c"}, {"c", "a"}, {"a", "d"}, {"b", "d", "c"}})
+		reversed := make([]Outcome, len(outcomes))
+		for i, outcome := range outcomes {
+			reversed[len(outcomes)-1-i] = outcome
+		}
+
+		forward, err := FitPlackettLuce(ids, outcomes, config)
+		require.NoError(t, err)
+		backward, err := FitPlackettLuce(ids, reversed, config)
+		require.NoError(t, err)
+
+		for i := range ids {
+			assert.InDelta(t, forward.Ratings[i].Rating, backward.Ratings[i].Rating, 1e-6)
+			assert.InDelta(t, forward.Ratings[i].StdError, backward.Ratings[i].StdError, 1e-6)
+		}
+	})
+
+	t.Run("multi-way rankings are fitted directly", func(t *testing.T) {
+		comparison, err := createTestEngine().NewMultiWayComparison([]Rating{{ID: "c", Score: 1500}, {ID: "a", Score: 1500}, {ID: "d", Score: 1500}, {ID: "b", Score: 1500}})
+		require.NoError(t, err)
+
+		fit, err := FitPlackettLuce(ids, []Outcome{comparison.Outcome(), comparison.Outcome()}, config)
+		require.NoError(t, err)
+
+		byID := make(map[string]FitRating)
+		for _, rating := range fit.Ratings {
+			byID[rating.ID] = rating
+		}
+		assert.Greater(t, byID["c"].Rating, byID["a"].Rating)
+		assert.Greater(t, byID["a"].Rating, byID["d"].Rating)
+		assert.Greater(t, byID["d"].Rating, byID["b"].Rating)
+		assert.Equal(t, 2, byID["c"].Comparisons)
+		assert.Less(t, fit.LogLikelihood, 0.0)
+	})
+
+	t.Run("draws pull strengths together", func(t *testing.T) {
+		win, err := FitPlackettLuce(ids, toOutcomes([][]string{{"a", "b"}, {"a", "b"}}), config)
+		require.NoError(t, err)
+		drawn, err := FitPlackettLuce(ids, []Outcome{NewOutcome("a", "b"), NewDrawOutcome("a", "b")}, config)
+		require.NoError(t, err)
+
+		assert.Greater(t, drawn.Ratings[0].Rating, drawn.Ratings[1].Rating)
+		assert.Less(t, drawn.Ratings[0].Rating, win.Ratings[0].Rating)
+
+		balanced, err := FitPlackettLuce(ids, []Outcome{NewDrawOutcome("a", "b")}, config)
+		require.NoError(t, err)
+		assert.InDelta(t, balanced.Ratings[0].Rating, balanced.Ratings[1].Rating, 1e-6)
+		assert.Less(t, balanced.Ratings[0].StdError, balanced.Ratings[2].StdError)
+	})
+
+	t.Run("tied multi-way positions share the choice", func(t *testing.T) {
+		tied := Outcome{Ranking: []string{"a", "b", "c"}, Positions: []int{0, 0, 2}}
+		fit, err := FitPlackettLuce(ids, []Outcome{tied}, config)
+		require.NoError(t, err)
+
+		assert.InDelta(t, fit.Ratings[0].Rating, fit.Ratings[1].Rating, 1e-6)
+		assert.Greater(t, fit.Ratings[1].Rating, fit.Ratings[2].Rating)
+
+		_, err = FitPlackettLuce(ids, []Outcome{{Ranking: []string{"a", "b"}, Positions: []int{1, 0}}}, config)
+		assert.ErrorIs(t, err, ErrInvalidPositions)
+	})
+
+	t.Run("unordered proposals of a partial ranking are not chosen", func(t *testing.T) {
+		partial, err := FitPlackettLuce(ids, []Outcome{NewTopOutcome(1, "a", "b", "c", "d")}, config)
+		require.NoError(t, err)
+		tied, err := FitPlackettLuce(ids, []Outcome{{Ranking: []string{"a", "b", "c", "d"}, Positions: []int{0, 1, 1, 1}}}, config)
+		require.NoError(t, err)
+
+		assert.Greater(t, partial.Ratings[0].Rating, partial.Ratings[1].Rating)
+		assert.InDelta(t, partial.Ratings[1].Rating, partial.Ratings[3].Rating, 1e-6)
+		assert.Greater(t, partial.Ratings[0].Rating, tied.Ratings[0].Rating)
+	})
+
+	t.Run("more comparisons shrink the standard error", func(t *testing.T) {
+		few, err := FitPlackettLuce(ids, toOutcomes([][]string{{"a", "b"}}), config)
+		require.NoError(t, err)
+		many, err := FitPlackettLuce(ids, toOutcomes([][]string{{"a", "b"}, {"b", "a"}, {"a", "b"}, {"b", "a"}}), config)
+		require.NoError(t, err)
+
+		assert.Less(t, many.Ratings[0].StdError, few.Ratings[0].StdError)
+	})
+
+	t.Run("uncompared proposals stay at the prior", func(t *testing.T) {
+		fit, err := FitPlackettLuce(ids, toOutcomes([][]string{{"a", "b"}}), config)
+		require.NoError(t, err)
+
+		d := fit.Ratings[3]
+		assert.InDelta(t, 1500.0, d.Rating, 1e-6)
+		assert.InDelta(t, eloPerLogStrength*math.Sqrt2, d.StdError, 1e-6)
+		assert.Equal(t, 0, d.Comparisons)
+	})
+
+	t.Run("validates input", func(t *testing.T) {
+		_, err := FitPlackettLuce(ids, toOutcomes([][]string{{"a", "x"}}), config)
+		assert.ErrorIs(t, err, ErrUnknownProposal)
+
+		_, err = FitPlackettLuce(ids, toOutcomes([][]string{{"a"}}), config)
+		assert.ErrorIs(t, err, ErrTooFewProposals)
+
+		_, err = FitPlackettLuce(ids, toOutcomes([][]string{{"a", "a"}}), config)
+		assert.ErrorIs(t, err, ErrDuplicateProposal)
+
+		_, err = FitPlackettLuce([]string{"a", "a"}, nil, config)
+		assert.ErrorIs(t, err, ErrDuplicateProposal)
+
+		invalid := config
+		invalid.PriorWeight = 0
+		_, err = FitPlackettLuce(ids, nil, invalid)
+		assert.ErrorIs(t, err, ErrInvalidFitConfig)
+	})
+}
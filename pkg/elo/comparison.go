@@ -7,11 +7,16 @@ import (
 	"time"
 )
 
+// MaxGroupSize is the largest number of proposals a multi-way comparison can rank
+const MaxGroupSize = 8
+
 // Additional error types for multi-way comparisons
 var (
 	ErrTooFewProposals  = errors.New("multi-way comparison requires at least 2 proposals")
-	ErrTooManyProposals = errors.New("multi-way comparison supports at most 4 proposals")
+	ErrTooManyProposals = errors.New("multi-way comparison supports at most 8 proposals")
 	ErrInvalidRanking   = errors.New("ranking must contain all proposal IDs exactly once")
+	ErrInvalidPositions = errors.New("positions must start at 0 and not decrease")
+	ErrNoOrderedPairs   = errors.New("partial ranking must place at least one proposal above another")
 )
 
 // PairwiseGame represents a single pairwise game within a multi-way comparison
@@ -21,28 +26,44 @@ type PairwiseGame struct {
 	Weight       float64 // Position-based weight for this game (0.6-1.0)
 	ExpectedWin  float64 // Expected probability of winner winning
 	ActualScore  float64 // Actual score (1 for win, 0 for loss)
+	Draw         bool    // Whether both proposals share a position (each scores 0.5)
 	RatingChange float64 // Rating change for winner (negative of loser's change)
 }
 
-// MultiWayComparison handles trio and quartet ranking comparisons
+// MultiWayComparison handles ranking comparisons of three to eight proposals
 type MultiWayComparison struct {
 	Engine       *Engine            // Elo engine for calculations
 	Proposals    []Rating           // Proposals being compared (ranked 1st to last)
-	Method       ComparisonMethod   // Trio or Quartet
+	Positions    []int              // 0-based finishing position of each proposal; equal positions are tied
+	Unordered    bool               // Proposals sharing a position were not ordered, so they play no game instead of drawing
+	Method       ComparisonMethod   // Trio, Quartet or Group
 	Games        []PairwiseGame     // Generated pairwise games
 	TotalChanges map[string]float64 // Total rating change per proposal
 }
 
 // NewMultiWayComparison creates a new multi-way comparison from ranked proposals
 // rankings: Proposals ordered from best (1st place) to worst (last place)
-func (e *Engine) NewMultiWayComparison(rankings []Rating) (*MultiWayComparison, error) {
+// positions: Optional 0-based finishing position of each proposal; proposals sharing
+// a position are tied (e.g. 0, 0, 2 for two joint winners ahead of a third)
+func (e *Engine) NewMultiWayComparison(rankings []Rating, positions ...int) (*MultiWayComparison, error) {
 	if len(rankings) < 2 {
 		return nil, ErrTooFewProposals
 	}
-	if len(rankings) > 4 {
+	if len(rankings) > MaxGroupSize {
 		return nil, ErrTooManyProposals
 	}
 
+	// Without explicit positions every proposal has its own place
+	if len(positions) == 0 {
+		positions = make([]int, len(rankings))
+		for i := range positions {
+			positions[i] = i
+		}
+	}
+	if err := validatePositions(positions, len(rankings)); err != nil {
+		return nil, err
+	}
+
 	// Validate unique proposal IDs
 	seen := make(map[string]bool)
 	for _, rating := range rankings {
@@ -57,26 +78,66 @@ func (e *Engine) NewMultiWayComparison(rankings []Rating) (*MultiWayComparison,
 		}
 	}
 
-	// Determine method based on number of proposals
-	var method ComparisonMethod
-	switch len(rankings) {
-	case 2:
-		method = Pairwise
-	case 3:
-		method = Trio
-	case 4:
-		method = Quartet
-	}
-
 	return &MultiWayComparison{
 		Engine:       e,
 		Proposals:    rankings,
-		Method:       method,
+		Positions:    positions,
+		Method:       groupMethod(len(rankings)),
 		Games:        []PairwiseGame{},
 		TotalChanges: make(map[string]float64),
 	}, nil
 }
 
+// NewPartialComparison creates a multi-way comparison from a partial order of proposals.
+// Proposals sharing a position were not ordered against each other: only proposals at
+// different positions play a game. Positions 0, 1, 2, 3, 3, 3 rank the top 3 of 6;
+// positions 0, 0, 0, 3, 3, 3 pick the best 3 of 6 without ordering them.
+func (e *Engine) NewPartialComparison(rankings []Rating, positions []int) (*MultiWayComparison, error) {
+	if len(positions) == 0 {
+		return nil, fmt.Errorf("%w: a partial ranking needs explicit positions", ErrInvalidPositions)
+	}
+	comparison, err := e.NewMultiWayComparison(rankings, positions...)
+	if err != nil {
+		return nil, err
+	}
+	if positions[len(positions)-1] == 0 {
+		return nil, ErrNoOrderedPairs
+	}
+
+	comparison.Unordered = true
+	return comparison, nil
+}
+
+// groupMethod returns the comparison method for a number of proposals
+func groupMethod(count int) ComparisonMethod {
+	switch count {
+	case 2:
+		return Pairwise
+	case 3:
+		return Trio
+	case 4:
+		return Quartet
+	default:
+		return Group
+	}
+}
+
+// validatePositions checks that positions cover every proposal, start at 0 and never decrease
+func validatePositions(positions []int, count int) error {
+	if len(positions) != count {
+		return fmt.Errorf("%w: got %d positions for %d proposals", ErrInvalidPositions, len(positions), count)
+	}
+	if positions[0] != 0 {
+		return ErrInvalidPositions
+	}
+	for i := 1; i < len(positions); i++ {
+		if positions[i] < positions[i-1] || positions[i] > i {
+			return ErrInvalidPositions
+		}
+	}
+	return nil
+}
+
 // calculatePositionWeight determines the weight for a game based on position difference
 // higherPos: Position of higher-ranked proposal (0-based, 0 = 1st place)
 // lowerPos: Position of lower-ranked proposal (0-based)
@@ -103,13 +164,18 @@ func (mw *MultiWayComparison) generatePairwiseGames() error {
 	mw.Games = []PairwiseGame{}
 
 	// Generate all pairwise combinations where higher-ranked beats lower-ranked
+	// (proposals sharing a position draw instead, or play no game in a partial order)
 	for i := range n {
 		for j := i + 1; j < n; j++ {
+			if mw.Unordered && mw.Positions[i] == mw.Positions[j] {
+				continue
+			}
+
 			winner := mw.Proposals[i] // Higher ranked (lower index)
 			loser := mw.Proposals[j]  // Lower ranked (higher index)
 
 			// Calculate position weight
-			weight := calculatePositionWeight(i, j)
+			weight := calculatePositionWeight(mw.Positions[i], mw.Positions[j])
 
 			// Calculate expected score for winner
 			expectedWin := mw.Engine.calculateExpectedScore(winner.Score, loser.Score)
@@ -120,6 +186,10 @@ func (mw *MultiWayComparison) generatePairwiseGames() error {
 				Weight:      weight,
 				ExpectedWin: expectedWin,
 				ActualScore: weight, // Winner gets weighted score (not just 1.0)
+				Draw:        mw.Positions[i] == mw.Positions[j],
+			}
+			if game.Draw {
+				game.ActualScore = weight * 0.5
 			}
 
 			mw.Games = append(mw.Games, game)
@@ -147,9 +217,13 @@ func (mw *MultiWayComparison) calculateRatingChanges() error {
 		expectedWinnerScore := game.ExpectedWin
 		expectedLoserScore := 1.0 - expectedWinnerScore
 
-		// Calculate base change (as if it was a normal 1.0 vs 0.0 game)
-		baseWinnerChange := float64(mw.Engine.KFactor) * (1.0 - expectedWinnerScore)
-		baseLoserChange := float64(mw.Engine.KFactor) * (0.0 - expectedLoserScore)
+		// Calculate base change (as if it was a normal 1.0 vs 0.0 game, or 0.5 each for a draw)
+		actualWinnerScore := 1.0
+		if game.Draw {
+			actualWinnerScore = 0.5
+		}
+		baseWinnerChange := float64(mw.Engine.KFactor) * (actualWinnerScore - expectedWinnerScore)
+		baseLoserChange := float64(mw.Engine.KFactor) * (1.0 - actualWinnerScore - expectedLoserScore)
 
 		// Apply weight scaling while preserving zero-sum
 		winnerChange := baseWinnerChange * game.Weight
@@ -228,8 +302,20 @@ func (mw *MultiWayComparison) Execute() ([]Rating, ComparisonResult, error) {
 }
 
 // CalculateMultiway is a convenience method on Engine for multi-way comparisons
-func (e *Engine) CalculateMultiway(rankings []Rating) ([]Rating, ComparisonResult, error) {
-	comparison, err := e.NewMultiWayComparison(rankings)
+// (optional positions mark tied proposals as in NewMultiWayComparison)
+func (e *Engine) CalculateMultiway(rankings []Rating, positions ...int) ([]Rating, ComparisonResult, error) {
+	comparison, err := e.NewMultiWayComparison(rankings, positions...)
+	if err != nil {
+		return nil, ComparisonResult{}, err
+	}
+
+	return comparison.Execute()
+}
+
+// CalculatePartial is a convenience method on Engine for partial orders of proposals
+// (positions as in NewPartialComparison)
+func (e *Engine) CalculatePartial(rankings []Rating, positions []int) ([]Rating, ComparisonResult, error) {
+	comparison, err := e.NewPartialComparison(rankings, positions)
 	if err != nil {
 		return nil, ComparisonResult{}, err
 	}
@@ -261,3 +347,17 @@ func (mw *MultiWayComparison) ValidateRatingConservation() error {
 
 	return nil
 }
+
+// Outcome returns the ranking and tied positions of the comparison, as used by Replay and FitPlackettLuce
+func (mw *MultiWayComparison) Outcome() Outcome {
+	outcome := Outcome{
+		Ranking:   make([]string, len(mw.Proposals)),
+		Positions: make([]int, len(mw.Positions)),
+		Unordered: mw.Unordered,
+	}
+	for i, proposal := range mw.Proposals {
+		outcome.Ranking[i] = proposal.ID
+	}
+	copy(outcome.Positions, mw.Positions)
+	return outcome
+}
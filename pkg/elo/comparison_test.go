@@ -102,13 +102,7 @@ func TestNewMultiWayComparison(t *testing.T) {
 	})
 
 	t.Run("too many proposals", func(t *testing.T) {
-		rankings := []Rating{
-			createTestRating("A", 1800.0, 10),
-			createTestRating("B", 1600.0, 8),
-			createTestRating("C", 1400.0, 6),
-			createTestRating("D", 1200.0, 4),
-			createTestRating("E", 1000.0, 2),
-		}
+		rankings := createUniformRatings(MaxGroupSize+1, 1500)
 
 		comparison, err := engine.NewMultiWayComparison(rankings)
 		assert.Error(t, err)
@@ -334,6 +328,128 @@ func TestEngineCalculateMultiway(t *testing.T) {
 	})
 }
 
+func TestMultiWayTiedPositions(t *testing.T) {
+	engine := createTestEngine()
+
+	t.Run("tied proposals draw against each other", func(t *testing.T) {
+		rankings := []Rating{
+			createTestRating("A", 1500.0, 0),
+			createTestRating("B", 1500.0, 0),
+			createTestRating("C", 1500.0, 0),
+		}
+
+		comparison, err := engine.NewMultiWayComparison(rankings, 0, 0, 2)
+		require.NoError(t, err)
+		updated, _, err := comparison.Execute()
+		require.NoError(t, err)
+
+		require.Len(t, comparison.Games, 3)
+		assert.True(t, comparison.Games[0].Draw)
+		assert.False(t, comparison.Games[1].Draw)
+		assert.InDelta(t, updated[0].Score, updated[1].Score, tolerance)
+		assert.Greater(t, updated[1].Score, updated[2].Score)
+		assert.NoError(t, comparison.ValidateRatingConservation())
+		assert.Equal(t, Outcome{Ranking: []string{"A", "B", "C"}, Positions: []int{0, 0, 2}}, comparison.Outcome())
+	})
+
+	t.Run("all tied at equal ratings changes nothing", func(t *testing.T) {
+		rankings := []Rating{
+			createTestRating("A", 1500.0, 0),
+			createTestRating("B", 1500.0, 0),
+			createTestRating("C", 1500.0, 0),
+			createTestRating("D", 1500.0, 0),
+		}
+
+		updated, _, err := engine.CalculateMultiway(rankings, 0, 0, 0, 0)
+		require.NoError(t, err)
+		for _, rating := range updated {
+			assert.InDelta(t, 1500.0, rating.Score, tolerance)
+		}
+	})
+
+	t.Run("default positions rank every proposal separately", func(t *testing.T) {
+		comparison, err := engine.NewMultiWayComparison([]Rating{createTestRating("A", 1500.0, 0), createTestRating("B", 1500.0, 0)})
+		require.NoError(t, err)
+		assert.Equal(t, []int{0, 1}, comparison.Positions)
+	})
+
+	t.Run("rejects invalid positions", func(t *testing.T) {
+		rankings := []Rating{
+			createTestRating("A", 1500.0, 0),
+			createTestRating("B", 1500.0, 0),
+			createTestRating("C", 1500.0, 0),
+		}
+
+		for _, positions := range [][]int{{0, 1}, {1, 1, 2}, {0, 2, 1}, {0, 2, 2}} {
+			_, err := engine.NewMultiWayComparison(rankings, positions...)
+			assert.ErrorIs(t, err, ErrInvalidPositions, "positions %v", positions)
+		}
+	})
+}
+
+func TestPartialComparison(t *testing.T) {
+	engine := createTestEngine()
+
+	t.Run("group method for more than four proposals", func(t *testing.T) {
+		comparison, err := engine.NewMultiWayComparison(createUniformRatings(MaxGroupSize, 1500))
+		require.NoError(t, err)
+		assert.Equal(t, Group, comparison.Method)
+	})
+
+	t.Run("top 3 of 6 leaves the rest unordered", func(t *testing.T) {
+		rankings := createUniformRatings(6, 1500)
+
+		comparison, err := engine.NewPartialComparison(rankings, TopPositions(6, 3))
+		require.NoError(t, err)
+		updated, result, err := comparison.Execute()
+		require.NoError(t, err)
+
+		// Every pair except the three among the unordered rest
+		assert.Len(t, comparison.Games, GetExpectedGameCount(6)-3)
+		assert.Equal(t, Group, result.Method)
+		assert.Greater(t, updated[0].Score, updated[1].Score)
+		assert.Greater(t, updated[1].Score, updated[2].Score)
+		assert.Greater(t, updated[2].Score, updated[3].Score)
+		assert.InDelta(t, updated[3].Score, updated[5].Score, tolerance)
+		assert.Equal(t, 5, updated[0].Games)
+		assert.Equal(t, 3, updated[5].Games)
+		assert.NoError(t, comparison.ValidateRatingConservation())
+		assert.Equal(t, NewTopOutcome(3, "p01", "p02", "p03", "p04", "p05", "p06"), comparison.Outcome())
+	})
+
+	t.Run("best 3 of 6 only plays picked against the rest", func(t *testing.T) {
+		rankings := createUniformRatings(6, 1500)
+
+		comparison, err := engine.NewPartialComparison(rankings, PickPositions(6, 3))
+		require.NoError(t, err)
+		updated, _, err := comparison.Execute()
+		require.NoError(t, err)
+
+		assert.Len(t, comparison.Games, 9)
+		for _, game := range comparison.Games {
+			assert.False(t, game.Draw)
+			assert.Equal(t, 1.0, game.Weight)
+		}
+		assert.InDelta(t, updated[0].Score, updated[2].Score, tolerance)
+		assert.Greater(t, updated[2].Score, updated[3].Score)
+		assert.NoError(t, comparison.ValidateRatingConservation())
+	})
+
+	t.Run("rejects partial orders without games", func(t *testing.T) {
+		rankings := createUniformRatings(5, 1500)
+
+		_, _, err := engine.CalculatePartial(rankings, PickPositions(5, 5))
+		assert.ErrorIs(t, err, ErrNoOrderedPairs)
+		_, _, err = engine.CalculatePartial(rankings, nil)
+		assert.ErrorIs(t, err, ErrInvalidPositions)
+	})
+
+	t.Run("positions", func(t *testing.T) {
+		assert.Equal(t, []int{0, 1, 2, 3, 3, 3}, TopPositions(6, 3))
+		assert.Equal(t, []int{0, 0, 2, 2, 2}, PickPositions(5, 2))
+	})
+}
+
 func TestMultiWayEdgeCases(t *testing.T) {
 	engine := createTestEngine()
 
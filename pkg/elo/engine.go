@@ -26,6 +26,7 @@ const (
 	Pairwise ComparisonMethod = "pairwise" // Two-proposal comparison
 	Trio     ComparisonMethod = "trio"     // Three-proposal ranking
 	Quartet  ComparisonMethod = "quartet"  // Four-proposal ranking
+	Group    ComparisonMethod = "group"    // Five to eight proposals, fully or partially ranked
 )
 
 // Rating represents a proposal's rating information
@@ -34,6 +35,8 @@ type Rating struct {
 	Score      float64 // Current Elo rating
 	Confidence float64 // Statistical confidence (0.0-1.0)
 	Games      int     // Number of comparisons participated in
+	Deviation  float64 // Rating deviation (Glicko-2 only, 0 when unused)
+	Volatility float64 // Rating volatility (Glicko-2 only, 0 when unused)
 }
 
 // RatingUpdate represents an individual rating change record
@@ -119,6 +122,18 @@ func (e *Engine) calculateExpectedScore(ratingA, ratingB float64) float64 {
 // loser: Rating of the losing proposal
 // Returns updated ratings for both proposals
 func (e *Engine) CalculatePairwise(winner, loser Rating) (Rating, Rating, error) {
+	// Actual scores: winner gets 1, loser gets 0
+	return e.calculateGame(winner, loser, 1.0)
+}
+
+// CalculateDraw calculates new ratings for a pairwise comparison judged equally good
+// Both proposals score 0.5, so the lower-rated one gains what the higher-rated one loses
+func (e *Engine) CalculateDraw(a, b Rating) (Rating, Rating, error) {
+	return e.calculateGame(a, b, 0.5)
+}
+
+// calculateGame updates two ratings for a game in which the first proposal scored actualWinner
+func (e *Engine) calculateGame(winner, loser Rating, actualWinner float64) (Rating, Rating, error) {
 	// Validate input ratings
 	if err := e.validateRating(winner.Score); err != nil {
 		return Rating{}, Rating{}, err
@@ -131,9 +146,7 @@ func (e *Engine) CalculatePairwise(winner, loser Rating) (Rating, Rating, error)
 	expectedWinner := e.calculateExpectedScore(winner.Score, loser.Score)
 	expectedLoser := e.calculateExpectedScore(loser.Score, winner.Score)
 
-	// Actual scores: winner gets 1, loser gets 0
-	actualWinner := 1.0
-	actualLoser := 0.0
+	actualLoser := 1.0 - actualWinner
 
 	// Calculate rating changes
 	winnerDelta := float64(e.KFactor) * (actualWinner - expectedWinner)
@@ -256,14 +269,21 @@ func (e *Engine) GetRatingBins(proposals []Rating, binSize float64) map[int][]st
 	return bins
 }
 
-// GetOptimalMatchup returns the most informative next comparison
+// GetOptimalMatchup returns the most informative next comparison.
+// Candidates are weighted by per-proposal coverage (Rating.Games against MinCoverage/MaxCoverage),
+// and a CrossBinRate share of turns is spent on cross-bin calibration matchups.
 func (e *Engine) GetOptimalMatchup(proposals []Rating, history *ComparisonHistory, config OptimizationConfig) *Matchup {
 	if len(proposals) < 2 {
 		return nil
 	}
 
-	// Create rating bins
+	if history == nil {
+		history = NewComparisonHistory()
+	}
+
+	// Create rating bins, visited in rating order for deterministic tie-breaking
 	bins := e.GetRatingBins(proposals, config.BinSize)
+	binIndices := sortedBinIndices(bins)
 
 	// Create proposal lookup map
 	proposalMap := make(map[string]Rating)
@@ -271,87 +291,73 @@ func (e *Engine) GetOptimalMatchup(proposals []Rating, history *ComparisonHistor
 		proposalMap[proposal.ID] = proposal
 	}
 
-	bestMatchup := &Matchup{
-		Priority:    0,
-		Information: -1.0,
-	}
-
 	// Look for optimal matchups following priority order:
 	// 1. Within-bin matchups (80-85%)
 	// 2. Adjacent-bin matchups (10-15%)
-	// 3. Cross-bin calibration (5-15%)
+	// 3. Cross-bin calibration (CrossBinRate share of turns)
 
-	// Track all potential matchups
+	// Track all potential matchups; pairs where both proposals reached MaxCoverage are skipped
 	var candidates []Matchup
-
-	// Within-bin and adjacent-bin matchups
-	for binIndex, proposalIDs := range bins {
-		// Within-bin matchups
-		for i := range proposalIDs {
-			for j := i + 1; j < len(proposalIDs); j++ {
-				proposalA := proposalMap[proposalIDs[i]]
-				proposalB := proposalMap[proposalIDs[j]]
-
-				matchup := e.evaluateMatchup(proposalA, proposalB, history)
-				candidates = append(candidates, matchup)
-			}
+	consider := func(idA, idB string, priorityPenalty int) {
+		proposalA, proposalB := proposalMap[idA], proposalMap[idB]
+		if reachedMaxCoverage(proposalA.Games, config) && reachedMaxCoverage(proposalB.Games, config) {
+			return
 		}
-
-		// Adjacent-bin matchups
-		adjacentBin := bins[binIndex+1]
-		if adjacentBin != nil {
-			for _, idA := range proposalIDs {
-				for _, idB := range adjacentBin {
-					proposalA := proposalMap[idA]
-					proposalB := proposalMap[idB]
-
-					matchup := e.evaluateMatchup(proposalA, proposalB, history)
-					// Slightly lower priority for adjacent bins
-					matchup.Priority = max(1, matchup.Priority-1)
-					candidates = append(candidates, matchup)
+		matchup := e.evaluateMatchup(proposalA, proposalB, history)
+		matchup.Priority = max(1, matchup.Priority-priorityPenalty)
+		candidates = append(candidates, matchup)
+	}
+
+	// Occasional cross-bin calibration between non-neighbouring bins
+	if isCalibrationTurn(len(history.Comparisons), config.CrossBinRate) {
+		for i := range binIndices {
+			for j := i + 2; j < len(binIndices); j++ {
+				for _, idA := range bins[binIndices[i]] {
+					for _, idB := range bins[binIndices[j]] {
+						consider(idA, idB, 1) // Lower priority for cross-bin
+					}
 				}
 			}
 		}
 	}
 
-	// Occasional cross-bin calibration
-	if len(history.Comparisons)%10 < int(config.CrossBinRate*10) {
-		// Add some cross-bin matchups for calibration
-		binIndices := make([]int, 0, len(bins))
-		for binIndex := range bins {
-			binIndices = append(binIndices, binIndex)
-		}
-
-		for i := 0; i < len(binIndices) && i < 3; i++ {
-			for j := i + 2; j < len(binIndices) && j < i+5; j++ {
-				binA := bins[binIndices[i]]
-				binB := bins[binIndices[j]]
+	// Within-bin and adjacent-bin matchups (also the fallback when no calibration pair exists)
+	if len(candidates) == 0 {
+		for binPos, binIndex := range binIndices {
+			proposalIDs := bins[binIndex]
 
-				if len(binA) > 0 && len(binB) > 0 {
-					// Take one representative from each bin
-					proposalA := proposalMap[binA[0]]
-					proposalB := proposalMap[binB[0]]
+			// Within-bin matchups
+			for i := range proposalIDs {
+				for j := i + 1; j < len(proposalIDs); j++ {
+					consider(proposalIDs[i], proposalIDs[j], 0)
+				}
+			}
 
-					matchup := e.evaluateMatchup(proposalA, proposalB, history)
-					matchup.Priority = max(1, matchup.Priority-1) // Lower priority for cross-bin
-					candidates = append(candidates, matchup)
+			// Matchups with the next non-empty bin, slightly lower priority
+			if binPos+1 < len(binIndices) {
+				for _, idA := range proposalIDs {
+					for _, idB := range bins[binIndices[binPos+1]] {
+						consider(idA, idB, 1)
+					}
 				}
 			}
 		}
 	}
 
-	// Find the best matchup
-	for _, candidate := range candidates {
-		if candidate.Information > bestMatchup.Information {
-			*bestMatchup = candidate
+	// Find the best matchup, favouring under-covered proposals
+	var bestMatchup *Matchup
+	bestScore := -1.0
+	for i, candidate := range candidates {
+		score := candidate.Information *
+			coverageWeight(proposalMap[candidate.ProposalA].Games, config) *
+			coverageWeight(proposalMap[candidate.ProposalB].Games, config)
+		if score > bestScore {
+			bestScore = score
+			bestMatchup = &candidates[i]
 		}
 	}
 
-	if bestMatchup.Information < 0 {
-		return nil // No valid matchup found
-	}
-
-	return bestMatchup
+	return bestMatchup // nil when no valid matchup found
 }
 
 // evaluateMatchup calculates the quality metrics for a potential matchup
@@ -376,7 +382,9 @@ func (e *Engine) evaluateMatchup(proposalA, proposalB Rating, history *Compariso
 	}
 }
 
-// CheckConvergence evaluates multiple stopping criteria and recommends session termination
+// CheckConvergence evaluates multiple stopping criteria and recommends session termination.
+// Stopping is recommended once MinComparisons are done and the share of met criteria
+// reaches ConfidenceThreshold, or unconditionally when MaxComparisons is reached.
 func (e *Engine) CheckConvergence(
 	proposals []Rating,
 	history *ComparisonHistory,
@@ -386,7 +394,7 @@ func (e *Engine) CheckConvergence(
 		return &ConvergenceStatus{
 			ShouldStop:        false,
 			Confidence:        0.0,
-			RemainingEstimate: config.MinCoverage * len(proposals),
+			RemainingEstimate: max(config.MinCoverage*len(proposals), config.MinComparisons),
 			CriteriaMet:       map[string]bool{},
 			Metrics:           &ConvergenceMetrics{},
 		}
@@ -395,17 +403,17 @@ func (e *Engine) CheckConvergence(
 	metrics := e.calculateConvergenceMetrics(proposals, history, config)
 	criteriaMet := make(map[string]bool)
 
-	// Criterion 1: Rating Stability - average rating change <5 points per comparison
+	// Criterion 1: Rating Stability - average rating change below the stability threshold
 	criteriaMet["rating_stability"] = metrics.AvgRatingChange < config.StabilityThreshold
 
-	// Criterion 2: Ranking Stability - top N positions unchanged for stability window
-	criteriaMet["ranking_stability"] = metrics.RankingStability >= 0.8 // 80% stability
+	// Criterion 2: Ranking Stability - top N unchanged over the whole stability window
+	criteriaMet["ranking_stability"] = metrics.RankingStability >= 1.0
 
 	// Criterion 3: Variance Threshold - rating change variance approaches zero
 	criteriaMet["variance_threshold"] = metrics.RatingVariance < (config.StabilityThreshold * 0.5)
 
-	// Criterion 4: Minimum Coverage - each proposal in minimum comparisons
-	criteriaMet["minimum_coverage"] = metrics.CoveragePercentage >= float64(config.MinCoverage)
+	// Criterion 4: Minimum Coverage - every proposal in at least MinCoverage comparisons
+	criteriaMet["minimum_coverage"] = metrics.CoveragePercentage >= 1.0
 
 	// Count met criteria
 	metCount := 0
@@ -415,16 +423,21 @@ func (e *Engine) CheckConvergence(
 		}
 	}
 
-	// Recommend stopping if 3 out of 4 criteria are met
-	shouldStop := metCount >= 3
+	// Confidence is the share of criteria satisfied
+	confidence := float64(metCount) / float64(len(criteriaMet))
 
-	// Calculate confidence based on criteria satisfaction
-	confidence := float64(metCount) / 4.0
+	completed := len(history.Comparisons)
+	limitReached := config.MaxComparisons > 0 && completed >= config.MaxComparisons
+	shouldStop := limitReached ||
+		(completed >= config.MinComparisons && confidence >= config.ConfidenceThreshold)
 
 	// Estimate remaining comparisons
 	remaining := 0
 	if !shouldStop {
-		remaining = e.estimateRemainingComparisons(proposals, history, config)
+		remaining = max(e.estimateRemainingComparisons(proposals, history, config), config.MinComparisons-completed)
+		if config.MaxComparisons > 0 {
+			remaining = min(remaining, config.MaxComparisons-completed)
+		}
 	}
 
 	return &ConvergenceStatus{
@@ -489,37 +502,39 @@ func (e *Engine) calculateConvergenceMetrics(
 	}
 }
 
-// calculateRankingStability determines how stable the top-N rankings are
+// calculateRankingStability returns the share of the last StabilityWindow comparisons
+// after which the top-N proposals were the same set as now
 func (e *Engine) calculateRankingStability(
 	proposals []Rating,
 	history *ComparisonHistory,
 	config OptimizationConfig,
 ) float64 {
-	if len(history.Comparisons) < config.StabilityWindow {
+	if config.StabilityWindow <= 0 || len(history.Comparisons) < config.StabilityWindow {
 		return 0.0
 	}
 
 	// Get current top-N proposals
-	currentTopN := e.getTopNProposals(proposals, config.TopNForStability)
+	currentTopN := make(map[string]bool, config.TopNForStability)
+	for _, id := range e.getTopNProposals(proposals, config.TopNForStability) {
+		currentTopN[id] = true
+	}
 
 	// Look back through stability window and check consistency
 	stableCount := 0
-	checkPoints := min(config.StabilityWindow, len(history.Comparisons))
+	checkPoints := config.StabilityWindow
 
 	for i := len(history.Comparisons) - checkPoints; i < len(history.Comparisons); i++ {
 		// Reconstruct ratings at this point in time
 		historicalRatings := e.reconstructRatingsAtComparison(proposals, history, i)
-		historicalTopN := e.getTopNProposals(historicalRatings, config.TopNForStability)
 
-		// Check how many top-N positions are the same
-		matches := 0
-		for j, currentID := range currentTopN {
-			if j < len(historicalTopN) && historicalTopN[j] == currentID {
-				matches++
+		stable := true
+		for _, id := range e.getTopNProposals(historicalRatings, config.TopNForStability) {
+			if !currentTopN[id] {
+				stable = false
+				break
 			}
 		}
-
-		if matches >= int(float64(config.TopNForStability)*0.8) { // 80% match threshold
+		if stable {
 			stableCount++
 		}
 	}
@@ -527,7 +542,8 @@ func (e *Engine) calculateRankingStability(
 	return float64(stableCount) / float64(checkPoints)
 }
 
-// calculateCoveragePercentage determines what percentage of meaningful comparisons have been made
+// calculateCoveragePercentage determines how much of the minimum coverage has been reached:
+// the average share of MinCoverage comparisons each proposal took part in (0.0-1.0)
 func (e *Engine) calculateCoveragePercentage(
 	proposals []Rating,
 	history *ComparisonHistory,
@@ -536,27 +552,17 @@ func (e *Engine) calculateCoveragePercentage(
 	if len(proposals) == 0 {
 		return 0.0
 	}
+	if config.MinCoverage <= 0 {
+		return 1.0
+	}
 
-	// Count how many proposals have sufficient comparisons
-	sufficientCount := 0
-
+	coverage := 0.0
 	for _, proposal := range proposals {
-		comparisonCount := 0
-
-		// Count comparisons this proposal has participated in
-		for proposalID, count := range history.PairHistory {
-			if contains(proposalID, proposal.ID) {
-				comparisonCount += count
-			}
-		}
-
-		// Also count from rating history
-		if len(history.RatingHistory[proposal.ID]) >= config.MinCoverage {
-			sufficientCount++
-		}
+		comparisonCount := len(history.RatingHistory[proposal.ID])
+		coverage += math.Min(1.0, float64(comparisonCount)/float64(config.MinCoverage))
 	}
 
-	return float64(sufficientCount) / float64(len(proposals))
+	return coverage / float64(len(proposals))
 }
 
 // estimateRemainingComparisons calculates how many more comparisons are likely needed
@@ -610,15 +616,31 @@ func (e *Engine) getTopNProposals(proposals []Rating, n int) []string {
 	return result
 }
 
-// reconstructRatingsAtComparison rebuilds the rating state at a specific point in history
+// reconstructRatingsAtComparison rebuilds the rating state right after the comparison at
+// comparisonIndex by rolling back the rating changes of all later comparisons
 func (e *Engine) reconstructRatingsAtComparison(
 	currentProposals []Rating,
 	history *ComparisonHistory,
 	comparisonIndex int,
 ) []Rating {
-	// For simplicity, use current ratings as approximation
-	// In a full implementation, this would replay the rating history
-	return currentProposals
+	ratings := make([]Rating, len(currentProposals))
+	copy(ratings, currentProposals)
+
+	index := make(map[string]int, len(ratings))
+	for i, rating := range ratings {
+		index[rating.ID] = i
+	}
+
+	// Undo the updates of every comparison made after the requested one
+	for i := len(history.Comparisons) - 1; i > comparisonIndex; i-- {
+		for _, update := range history.Comparisons[i].Updates {
+			if j, exists := index[update.ProposalID]; exists {
+				ratings[j].Score -= update.Delta
+			}
+		}
+	}
+
+	return ratings
 }
 
 // Helper functions
@@ -849,11 +871,3 @@ func (e *Engine) UpdateComparisonHistory(history *ComparisonHistory, result Comp
 		history.AddComparison(result)
 	}
 }
-
-// contains checks if a colon-separated pair contains a specific ID
-func contains(pair, id string) bool {
-	return pair == id+":"+id ||
-		(len(pair) > len(id) &&
-			(pair[:len(id)+1] == id+":" ||
-				pair[len(pair)-len(id)-1:] == ":"+id))
-}
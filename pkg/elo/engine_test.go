@@ -228,6 +228,39 @@ func TestCalculatePairwise(t *testing.T) {
 	})
 }
 
+func TestCalculateDraw(t *testing.T) {
+	engine := createTestEngine()
+
+	t.Run("equal ratings stay unchanged", func(t *testing.T) {
+		newA, newB, err := engine.CalculateDraw(createRating("PROP001", 1500.0, 2), createRating("PROP002", 1500.0, 0))
+		require.NoError(t, err)
+
+		assert.InDelta(t, 1500.0, newA.Score, tolerance)
+		assert.InDelta(t, 1500.0, newB.Score, tolerance)
+		assert.Equal(t, 3, newA.Games)
+		assert.Equal(t, 1, newB.Games)
+	})
+
+	t.Run("lower rated proposal gains", func(t *testing.T) {
+		strong := createRating("PROP001", 1700.0, 5)
+		weak := createRating("PROP002", 1300.0, 5)
+
+		newStrong, newWeak, err := engine.CalculateDraw(strong, weak)
+		require.NoError(t, err)
+
+		expectedStrong := engine.calculateExpectedScore(strong.Score, weak.Score)
+		assert.InDelta(t, strong.Score+float64(engine.KFactor)*(0.5-expectedStrong), newStrong.Score, tolerance)
+		assert.Greater(t, newWeak.Score, weak.Score)
+		assert.InDelta(t, strong.Score-newStrong.Score, newWeak.Score-weak.Score, tolerance)
+
+		// Order of arguments does not matter for a draw
+		swappedWeak, swappedStrong, err := engine.CalculateDraw(weak, strong)
+		require.NoError(t, err)
+		assert.InDelta(t, newStrong.Score, swappedStrong.Score, tolerance)
+		assert.InDelta(t, newWeak.Score, swappedWeak.Score, tolerance)
+	})
+}
+
 func TestCalculateConfidence(t *testing.T) {
 	engine := createTestEngine()
 
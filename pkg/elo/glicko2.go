@@ -0,0 +1,328 @@
+package elo
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// Glicko-2 errors
+var (
+	ErrUnknownRatingSystem = errors.New("unknown rating system")
+	ErrInvalidDeviation    = errors.New("rating deviation must be positive")
+	ErrInvalidVolatility   = errors.New("volatility and tau must be positive")
+)
+
+// glicko2Scale converts between the Glicko rating scale and the internal Glicko-2 scale
+const glicko2Scale = 173.7178
+
+// glicko2Epsilon is the convergence tolerance of the volatility iteration
+const glicko2Epsilon = 0.000001
+
+// Glicko2Config holds configuration parameters for the Glicko-2 engine
+type Glicko2Config struct {
+	InitialRating     float64 // Rating of new proposals (centre of the scale)
+	InitialDeviation  float64 // Rating deviation of new proposals
+	InitialVolatility float64 // Volatility of new proposals
+	Tau               float64 // System constant constraining volatility changes
+	MinRating         float64 // Minimum allowed rating
+	MaxRating         float64 // Maximum allowed rating
+}
+
+// DefaultGlicko2Config returns the parameters recommended by Glickman
+func DefaultGlicko2Config() Glicko2Config {
+	return Glicko2Config{
+		InitialRating:     1500.0,
+		InitialDeviation:  350.0,
+		InitialVolatility: 0.06,
+		Tau:               0.5,
+		MinRating:         0.0,
+		MaxRating:         3000.0,
+	}
+}
+
+// Glicko2Engine rates proposals with the Glicko-2 system.
+// Every comparison is treated as one rating period in which each proposal
+// played all other proposals of the comparison.
+type Glicko2Engine struct {
+	InitialRating     float64 // Rating of new proposals (centre of the scale)
+	InitialDeviation  float64 // Rating deviation of new proposals
+	InitialVolatility float64 // Volatility of new proposals
+	Tau               float64 // System constant constraining volatility changes
+	MinRating         float64 // Minimum allowed rating
+	MaxRating         float64 // Maximum allowed rating
+}
+
+// NewGlicko2Engine creates a new Glicko-2 rating engine with specified configuration
+func NewGlicko2Engine(config Glicko2Config) (*Glicko2Engine, error) {
+	if config.MinRating >= config.MaxRating {
+		return nil, ErrInvalidBounds
+	}
+	if math.IsNaN(config.InitialRating) || math.IsInf(config.InitialRating, 0) {
+		return nil, ErrInvalidRating
+	}
+	if config.InitialDeviation <= 0 {
+		return nil, ErrInvalidDeviation
+	}
+	if config.InitialVolatility <= 0 || config.Tau <= 0 {
+		return nil, ErrInvalidVolatility
+	}
+
+	return &Glicko2Engine{
+		InitialRating:     config.InitialRating,
+		InitialDeviation:  config.InitialDeviation,
+		InitialVolatility: config.InitialVolatility,
+		Tau:               config.Tau,
+		MinRating:         config.MinRating,
+		MaxRating:         config.MaxRating,
+	}, nil
+}
+
+// System returns SystemGlicko2
+func (g *Glicko2Engine) System() RatingSystem {
+	return SystemGlicko2
+}
+
+// NewRating returns a rating with the initial rating, deviation and volatility
+func (g *Glicko2Engine) NewRating(id string) Rating {
+	return Rating{
+		ID:         id,
+		Score:      g.InitialRating,
+		Deviation:  g.InitialDeviation,
+		Volatility: g.InitialVolatility,
+	}
+}
+
+// CalculatePairwise calculates new ratings, deviations and volatilities for a pairwise comparison
+func (g *Glicko2Engine) CalculatePairwise(winner, loser Rating) (Rating, Rating, error) {
+	updated, err := g.ratePeriod([]Rating{winner, loser}, []int{0, 1}, false)
+	if err != nil {
+		return Rating{}, Rating{}, err
+	}
+	return updated[0], updated[1], nil
+}
+
+// CalculateDraw calculates new ratings, deviations and volatilities for two proposals judged equally good
+func (g *Glicko2Engine) CalculateDraw(a, b Rating) (Rating, Rating, error) {
+	updated, err := g.ratePeriod([]Rating{a, b}, []int{0, 0}, false)
+	if err != nil {
+		return Rating{}, Rating{}, err
+	}
+	return updated[0], updated[1], nil
+}
+
+// CalculateMultiway rates proposals ordered from best to worst; every proposal
+// wins against all proposals ranked below it within one rating period and draws
+// against proposals sharing its position
+func (g *Glicko2Engine) CalculateMultiway(rankings []Rating, positions ...int) ([]Rating, ComparisonResult, error) {
+	if len(positions) == 0 {
+		positions = make([]int, len(rankings))
+		for i := range positions {
+			positions[i] = i
+		}
+	}
+	return g.calculateGroup(rankings, positions, false)
+}
+
+// CalculatePartial rates proposals in a partial order within one rating period;
+// proposals sharing a position were not ordered and do not play each other
+func (g *Glicko2Engine) CalculatePartial(rankings []Rating, positions []int) ([]Rating, ComparisonResult, error) {
+	if len(positions) == 0 {
+		return nil, ComparisonResult{}, fmt.Errorf("%w: a partial ranking needs explicit positions", ErrInvalidPositions)
+	}
+	return g.calculateGroup(rankings, positions, true)
+}
+
+// calculateGroup validates and rates a multi-way comparison at the given positions
+func (g *Glicko2Engine) calculateGroup(rankings []Rating, positions []int, unordered bool) ([]Rating, ComparisonResult, error) {
+	start := time.Now()
+
+	if len(rankings) < 2 {
+		return nil, ComparisonResult{}, ErrTooFewProposals
+	}
+	if len(rankings) > MaxGroupSize {
+		return nil, ComparisonResult{}, ErrTooManyProposals
+	}
+	if err := validatePositions(positions, len(rankings)); err != nil {
+		return nil, ComparisonResult{}, err
+	}
+	if unordered && positions[len(positions)-1] == 0 {
+		return nil, ComparisonResult{}, ErrNoOrderedPairs
+	}
+
+	updated, err := g.ratePeriod(rankings, positions, unordered)
+	if err != nil {
+		return nil, ComparisonResult{}, err
+	}
+
+	updates := make([]RatingUpdate, len(updated))
+	for i, rating := range updated {
+		updates[i] = RatingUpdate{
+			ProposalID: rating.ID,
+			OldRating:  rankings[i].Score,
+			NewRating:  rating.Score,
+			Delta:      rating.Score - rankings[i].Score,
+		}
+	}
+
+	result := ComparisonResult{
+		Updates:   updates,
+		Method:    groupMethod(len(rankings)),
+		Timestamp: start,
+		Duration:  time.Since(start),
+	}
+
+	return updated, result, nil
+}
+
+// Replay applies a sequence of comparison outcomes to a set of starting ratings
+func (g *Glicko2Engine) Replay(ratings []Rating, outcomes []Outcome) ([]Rating, error) {
+	return replayOutcomes(g, ratings, outcomes)
+}
+
+// ConfidenceFromDeviation maps a rating deviation to a confidence between 0 and 1,
+// where the initial deviation means no confidence at all
+func (g *Glicko2Engine) ConfidenceFromDeviation(deviation float64) float64 {
+	return math.Max(0, math.Min(1, 1-deviation/g.InitialDeviation))
+}
+
+// ratePeriod updates proposals at their finishing positions against each other's pre-period ratings;
+// unordered proposals sharing a position are not opponents
+func (g *Glicko2Engine) ratePeriod(rankings []Rating, positions []int, unordered bool) ([]Rating, error) {
+	seen := make(map[string]bool, len(rankings))
+	players := make([]Rating, len(rankings))
+	for i, rating := range rankings {
+		if seen[rating.ID] {
+			return nil, ErrDuplicateProposal
+		}
+		seen[rating.ID] = true
+
+		if math.IsNaN(rating.Score) || math.IsInf(rating.Score, 0) {
+			return nil, ErrInvalidRating
+		}
+		players[i] = g.withDefaults(rating)
+	}
+
+	updated := make([]Rating, len(players))
+	for i, player := range players {
+		opponents := make([]Rating, 0, len(players)-1)
+		scores := make([]float64, 0, len(players)-1)
+		for j, opponent := range players {
+			if i == j || unordered && positions[i] == positions[j] {
+				continue
+			}
+			opponents = append(opponents, opponent)
+			switch {
+			case positions[i] < positions[j]:
+				scores = append(scores, 1.0) // Ranked above the opponent
+			case positions[i] == positions[j]:
+				scores = append(scores, 0.5) // Tied with the opponent
+			default:
+				scores = append(scores, 0.0)
+			}
+		}
+		updated[i] = g.update(player, opponents, scores)
+	}
+
+	return updated, nil
+}
+
+// withDefaults fills in the initial deviation and volatility of ratings that have none
+func (g *Glicko2Engine) withDefaults(rating Rating) Rating {
+	if rating.Deviation <= 0 {
+		rating.Deviation = g.InitialDeviation
+	}
+	if rating.Volatility <= 0 {
+		rating.Volatility = g.InitialVolatility
+	}
+	return rating
+}
+
+// update applies the Glicko-2 update steps for one player over one rating period
+func (g *Glicko2Engine) update(player Rating, opponents []Rating, scores []float64) Rating {
+	mu := (player.Score - g.InitialRating) / glicko2Scale
+	phi := player.Deviation / glicko2Scale
+	sigma := player.Volatility
+
+	// Estimated variance (v) and improvement (delta) from the period's results
+	varianceInverse := 0.0
+	improvement := 0.0
+	for i, opponent := range opponents {
+		opponentMu := (opponent.Score - g.InitialRating) / glicko2Scale
+		opponentG := glicko2G(opponent.Deviation / glicko2Scale)
+		expected := 1.0 / (1.0 + math.Exp(-opponentG*(mu-opponentMu)))
+
+		varianceInverse += opponentG * opponentG * expected * (1 - expected)
+		improvement += opponentG * (scores[i] - expected)
+	}
+	variance := 1.0 / varianceInverse
+	delta := variance * improvement
+
+	newSigma := g.updateVolatility(phi, sigma, variance, delta)
+
+	// Pre-period deviation grows with volatility, then shrinks with new information
+	phiStar := math.Sqrt(phi*phi + newSigma*newSigma)
+	newPhi := 1.0 / math.Sqrt(1.0/(phiStar*phiStar)+1.0/variance)
+	newMu := mu + newPhi*newPhi*improvement
+
+	games := player.Games + len(opponents)
+	newDeviation := newPhi * glicko2Scale
+
+	return Rating{
+		ID:         player.ID,
+		Score:      g.clampRating(newMu*glicko2Scale + g.InitialRating),
+		Confidence: g.ConfidenceFromDeviation(newDeviation),
+		Games:      games,
+		Deviation:  newDeviation,
+		Volatility: newSigma,
+	}
+}
+
+// updateVolatility finds the new volatility with the Illinois algorithm (Glickman, step 5)
+func (g *Glicko2Engine) updateVolatility(phi, sigma, variance, delta float64) float64 {
+	a := math.Log(sigma * sigma)
+	tau2 := g.Tau * g.Tau
+
+	f := func(x float64) float64 {
+		ex := math.Exp(x)
+		denominator := phi*phi + variance + ex
+		return ex*(delta*delta-phi*phi-variance-ex)/(2*denominator*denominator) - (x-a)/tau2
+	}
+
+	lower := a
+	var upper float64
+	if delta*delta > phi*phi+variance {
+		upper = math.Log(delta*delta - phi*phi - variance)
+	} else {
+		k := 1.0
+		for f(a-k*g.Tau) < 0 {
+			k++
+		}
+		upper = a - k*g.Tau
+	}
+
+	fLower, fUpper := f(lower), f(upper)
+	for math.Abs(upper-lower) > glicko2Epsilon {
+		next := lower + (lower-upper)*fLower/(fUpper-fLower)
+		fNext := f(next)
+		if fNext*fUpper <= 0 {
+			lower, fLower = upper, fUpper
+		} else {
+			fLower /= 2
+		}
+		upper, fUpper = next, fNext
+	}
+
+	return math.Exp(lower / 2)
+}
+
+// clampRating ensures a rating stays within configured bounds
+func (g *Glicko2Engine) clampRating(rating float64) float64 {
+	return math.Max(g.MinRating, math.Min(g.MaxRating, rating))
+}
+
+// glicko2G reduces the impact of games against opponents with uncertain ratings
+func glicko2G(phi float64) float64 {
+	return 1.0 / math.Sqrt(1.0+3.0*phi*phi/(math.Pi*math.Pi))
+}
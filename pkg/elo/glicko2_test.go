@@ -0,0 +1,185 @@
+package elo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestGlicko2Engine(t *testing.T) *Glicko2Engine {
+	engine, err := NewGlicko2Engine(DefaultGlicko2Config())
+	require.NoError(t, err)
+	return engine
+}
+
+func TestNewGlicko2Engine(t *testing.T) {
+	invalid := []struct {
+		name   string
+		modify func(*Glicko2Config)
+		err    error
+	}{
+		{"bounds", func(c *Glicko2Config) { c.MinRating = c.MaxRating }, ErrInvalidBounds},
+		{"deviation", func(c *Glicko2Config) { c.InitialDeviation = 0 }, ErrInvalidDeviation},
+		{"volatility", func(c *Glicko2Config) { c.InitialVolatility = -1 }, ErrInvalidVolatility},
+		{"tau", func(c *Glicko2Config) { c.Tau = 0 }, ErrInvalidVolatility},
+	}
+
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			config := DefaultGlicko2Config()
+			tt.modify(&config)
+			_, err := NewGlicko2Engine(config)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestGlicko2Engine(t *testing.T) {
+	engine := createTestGlicko2Engine(t)
+
+	t.Run("matches Glickman's worked example", func(t *testing.T) {
+		player := Rating{ID: "player", Score: 1500, Deviation: 200, Volatility: 0.06}
+		opponents := []Rating{
+			{ID: "a", Score: 1400, Deviation: 30},
+			{ID: "b", Score: 1550, Deviation: 100},
+			{ID: "c", Score: 1700, Deviation: 300},
+		}
+
+		updated := engine.update(player, opponents, []float64{1, 0, 0})
+		assert.InDelta(t, 1464.06, updated.Score, 0.01)
+		assert.InDelta(t, 151.52, updated.Deviation, 0.01)
+		assert.InDelta(t, 0.05999, updated.Volatility, 0.00001)
+		assert.Equal(t, 3, updated.Games)
+	})
+
+	t.Run("pairwise reduces deviation", func(t *testing.T) {
+		winner, loser, err := engine.CalculatePairwise(engine.NewRating("w"), engine.NewRating("l"))
+		require.NoError(t, err)
+
+		assert.Greater(t, winner.Score, 1500.0)
+		assert.Less(t, loser.Score, 1500.0)
+		assert.InDelta(t, 1500.0, (winner.Score+loser.Score)/2, 0.001)
+		assert.Less(t, winner.Deviation, 350.0)
+		assert.Less(t, loser.Deviation, 350.0)
+		assert.Greater(t, winner.Confidence, 0.0)
+	})
+
+	t.Run("missing deviation uses initial values", func(t *testing.T) {
+		fromDefaults, _, err := engine.CalculatePairwise(Rating{ID: "w", Score: 1500}, Rating{ID: "l", Score: 1500})
+		require.NoError(t, err)
+		fromNew, _, err := engine.CalculatePairwise(engine.NewRating("w"), engine.NewRating("l"))
+		require.NoError(t, err)
+		assert.Equal(t, fromNew, fromDefaults)
+	})
+
+	t.Run("certain ratings move less", func(t *testing.T) {
+		uncertain, _, err := engine.CalculatePairwise(engine.NewRating("w"), engine.NewRating("l"))
+		require.NoError(t, err)
+
+		settled := Rating{ID: "w", Score: 1500, Deviation: 60, Volatility: 0.06}
+		certain, _, err := engine.CalculatePairwise(settled, engine.NewRating("l"))
+		require.NoError(t, err)
+
+		assert.Less(t, certain.Score-1500, uncertain.Score-1500)
+	})
+
+	t.Run("draw keeps equal ratings and reduces deviation", func(t *testing.T) {
+		a, b, err := engine.CalculateDraw(engine.NewRating("a"), engine.NewRating("b"))
+		require.NoError(t, err)
+
+		assert.InDelta(t, 1500.0, a.Score, 1e-9)
+		assert.InDelta(t, 1500.0, b.Score, 1e-9)
+		assert.Less(t, a.Deviation, 350.0)
+	})
+
+	t.Run("multi-way orders ratings by rank", func(t *testing.T) {
+		ranked, result, err := engine.CalculateMultiway([]Rating{
+			engine.NewRating("first"), engine.NewRating("second"), engine.NewRating("third"),
+		})
+		require.NoError(t, err)
+		require.Len(t, ranked, 3)
+
+		assert.Equal(t, Trio, result.Method)
+		assert.Greater(t, ranked[0].Score, ranked[1].Score)
+		assert.Greater(t, ranked[1].Score, ranked[2].Score)
+		assert.Equal(t, 2, ranked[0].Games)
+	})
+
+	t.Run("multi-way validates input", func(t *testing.T) {
+		_, _, err := engine.CalculateMultiway([]Rating{engine.NewRating("a")})
+		assert.ErrorIs(t, err, ErrTooFewProposals)
+
+		_, _, err = engine.CalculateMultiway([]Rating{engine.NewRating("a"), engine.NewRating("a")})
+		assert.ErrorIs(t, err, ErrDuplicateProposal)
+	})
+
+	t.Run("partial ranking skips unordered opponents", func(t *testing.T) {
+		rankings := make([]Rating, 6)
+		for i := range rankings {
+			rankings[i] = engine.NewRating(string(rune('a' + i)))
+		}
+
+		ranked, result, err := engine.CalculatePartial(rankings, PickPositions(6, 2))
+		require.NoError(t, err)
+
+		assert.Equal(t, Group, result.Method)
+		assert.InDelta(t, ranked[0].Score, ranked[1].Score, 1e-9)
+		assert.Greater(t, ranked[1].Score, ranked[2].Score)
+		assert.Equal(t, 4, ranked[0].Games)
+		assert.Equal(t, 2, ranked[5].Games)
+
+		_, _, err = engine.CalculatePartial(rankings, PickPositions(6, 6))
+		assert.ErrorIs(t, err, ErrNoOrderedPairs)
+		_, _, err = engine.CalculateMultiway(append(rankings, engine.NewRating("g"), engine.NewRating("h"), engine.NewRating("i")))
+		assert.ErrorIs(t, err, ErrTooManyProposals)
+	})
+
+	t.Run("ratings stay within bounds", func(t *testing.T) {
+		config := DefaultGlicko2Config()
+		config.MaxRating = 1510
+		bounded, err := NewGlicko2Engine(config)
+		require.NoError(t, err)
+
+		winner, _, err := bounded.CalculatePairwise(bounded.NewRating("w"), bounded.NewRating("l"))
+		require.NoError(t, err)
+		assert.Equal(t, 1510.0, winner.Score)
+	})
+
+	t.Run("replay accumulates certainty", func(t *testing.T) {
+		ratings := []Rating{engine.NewRating("a"), engine.NewRating("b")}
+		replayed, err := engine.Replay(ratings, toOutcomes([][]string{{"a", "b"}, {"a", "b"}, {"a", "b"}}))
+		require.NoError(t, err)
+
+		assert.Greater(t, replayed[0].Score, replayed[1].Score)
+		assert.Less(t, replayed[0].Deviation, 250.0)
+		assert.Equal(t, 3, replayed[0].Games)
+	})
+}
+
+func TestNewRatingEngine(t *testing.T) {
+	config := Config{InitialRating: 1600, KFactor: 24, MinRating: 1000, MaxRating: 2200}
+
+	for _, system := range []RatingSystem{"", SystemElo} {
+		engine, err := NewRatingEngine(system, config)
+		require.NoError(t, err)
+		assert.Equal(t, SystemElo, engine.System())
+		assert.Equal(t, Rating{ID: "p", Score: 1600}, engine.NewRating("p"))
+	}
+
+	engine, err := NewRatingEngine(SystemGlicko2, config)
+	require.NoError(t, err)
+	assert.Equal(t, SystemGlicko2, engine.System())
+
+	rating := engine.NewRating("p")
+	assert.Equal(t, 1600.0, rating.Score)
+	assert.Equal(t, 350.0, rating.Deviation)
+
+	glicko, ok := engine.(*Glicko2Engine)
+	require.True(t, ok)
+	assert.Equal(t, 1000.0, glicko.MinRating)
+	assert.Equal(t, 2200.0, glicko.MaxRating)
+
+	_, err = NewRatingEngine("trueskill", config)
+	assert.ErrorIs(t, err, ErrUnknownRatingSystem)
+}
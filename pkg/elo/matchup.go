@@ -0,0 +1,220 @@
+package elo
+
+import (
+	"sort"
+)
+
+// cappedCoverageWeight is the selection weight of proposals that reached MaxCoverage
+const cappedCoverageWeight = 0.1
+
+// MatchupStrategy selects which proposals are presented in the next comparison
+type MatchupStrategy interface {
+	// NextGroup returns the IDs of size proposals to compare, or nil when no comparison is left
+	NextGroup(proposals []Rating, history *ComparisonHistory, size int) []string
+}
+
+// InformationGainStrategy prioritises close, under-compared proposals using rating bins
+type InformationGainStrategy struct {
+	Engine *Engine            // Engine providing rating bins and matchup evaluation
+	Config OptimizationConfig // Bin size, coverage limits and cross-bin calibration rate
+}
+
+// NewInformationGainStrategy creates an information-gain based matchup strategy
+func NewInformationGainStrategy(engine *Engine, config OptimizationConfig) *InformationGainStrategy {
+	return &InformationGainStrategy{
+		Engine: engine,
+		Config: config,
+	}
+}
+
+// NextGroup returns the most informative group of proposals for the next comparison
+func (s *InformationGainStrategy) NextGroup(proposals []Rating, history *ComparisonHistory, size int) []string {
+	return s.Engine.GetOptimalGroup(proposals, history, s.Config, size)
+}
+
+// SequentialStrategy presents proposals in input order: the first uncompared pair,
+// or consecutive groups in round-robin order for multi-way comparisons
+type SequentialStrategy struct{}
+
+// NextGroup returns the next proposals in input order
+func (SequentialStrategy) NextGroup(proposals []Rating, history *ComparisonHistory, size int) []string {
+	if size < 2 || len(proposals) < size {
+		return nil
+	}
+
+	if history == nil {
+		history = NewComparisonHistory()
+	}
+
+	// For pairwise comparisons, find next uncompared pair
+	if size == 2 {
+		for i := 0; i < len(proposals); i++ {
+			for j := i + 1; j < len(proposals); j++ {
+				if history.GetPairComparisonCount(proposals[i].ID, proposals[j].ID) == 0 {
+					return []string{proposals[i].ID, proposals[j].ID}
+				}
+			}
+		}
+		return nil
+	}
+
+	// For multi-way comparisons take the next sequential group
+	startIdx := len(history.Comparisons) % (len(proposals) - size + 1)
+	group := make([]string, size)
+	for i := range group {
+		group[i] = proposals[startIdx+i].ID
+	}
+	return group
+}
+
+// GroupedStrategy keeps most comparisons within a group of proposals (e.g. a track) and
+// spends CrossGroupRate of them on the whole set so the groups stay on a common scale
+type GroupedStrategy struct {
+	Base           MatchupStrategy   // Strategy selecting proposals within the chosen set
+	Groups         map[string]string // Group of each proposal ID
+	CrossGroupRate float64           // Share of comparisons that calibrate across groups (0-1)
+}
+
+// NewGroupedStrategy creates a strategy comparing mostly within groups
+func NewGroupedStrategy(base MatchupStrategy, groups map[string]string, crossGroupRate float64) *GroupedStrategy {
+	return &GroupedStrategy{
+		Base:           base,
+		Groups:         groups,
+		CrossGroupRate: crossGroupRate,
+	}
+}
+
+// NextGroup picks the least compared group that can fill a comparison and lets the base
+// strategy choose within it; calibration turns and exhausted groups use the whole set
+func (s *GroupedStrategy) NextGroup(proposals []Rating, history *ComparisonHistory, size int) []string {
+	if history == nil {
+		history = NewComparisonHistory()
+	}
+	if len(s.Groups) == 0 || isCalibrationTurn(len(history.Comparisons), s.CrossGroupRate) {
+		return s.Base.NextGroup(proposals, history, size)
+	}
+
+	members := make(map[string][]Rating)
+	names := make([]string, 0)
+	for _, proposal := range proposals {
+		group := s.Groups[proposal.ID]
+		if _, exists := members[group]; !exists {
+			names = append(names, group)
+		}
+		members[group] = append(members[group], proposal)
+	}
+
+	// Least compared groups first (average games per proposal), ties by name
+	averageGames := func(group string) float64 {
+		games := 0
+		for _, proposal := range members[group] {
+			games += proposal.Games
+		}
+		return float64(games) / float64(len(members[group]))
+	}
+	sort.SliceStable(names, func(i, j int) bool {
+		gamesI, gamesJ := averageGames(names[i]), averageGames(names[j])
+		if gamesI != gamesJ {
+			return gamesI < gamesJ
+		}
+		return names[i] < names[j]
+	})
+
+	for _, group := range names {
+		if len(members[group]) < size {
+			continue
+		}
+		if ids := s.Base.NextGroup(members[group], history, size); len(ids) == size {
+			return ids
+		}
+	}
+
+	return s.Base.NextGroup(proposals, history, size)
+}
+
+// GetOptimalGroup returns the most informative group of size proposals.
+// The group starts from GetOptimalMatchup and is grown greedily with the proposals
+// closest to all members, favouring those below MinCoverage.
+func (e *Engine) GetOptimalGroup(proposals []Rating, history *ComparisonHistory, config OptimizationConfig, size int) []string {
+	if size < 2 || len(proposals) < size {
+		return nil
+	}
+
+	if history == nil {
+		history = NewComparisonHistory()
+	}
+
+	matchup := e.GetOptimalMatchup(proposals, history, config)
+	if matchup == nil {
+		return nil
+	}
+
+	proposalMap := make(map[string]Rating, len(proposals))
+	for _, proposal := range proposals {
+		proposalMap[proposal.ID] = proposal
+	}
+
+	group := []string{matchup.ProposalA, matchup.ProposalB}
+	inGroup := map[string]bool{matchup.ProposalA: true, matchup.ProposalB: true}
+
+	for len(group) < size {
+		bestID := ""
+		bestScore := -1.0
+
+		for _, candidate := range proposals {
+			if inGroup[candidate.ID] {
+				continue
+			}
+
+			// Sum information gain against every current member
+			information := 0.0
+			for _, memberID := range group {
+				information += e.evaluateMatchup(proposalMap[memberID], candidate, history).Information
+			}
+
+			score := information * coverageWeight(candidate.Games, config)
+			if score > bestScore {
+				bestScore = score
+				bestID = candidate.ID
+			}
+		}
+
+		group = append(group, bestID)
+		inGroup[bestID] = true
+	}
+
+	return group
+}
+
+// coverageWeight favours proposals below MinCoverage and demotes those at MaxCoverage
+func coverageWeight(games int, config OptimizationConfig) float64 {
+	if reachedMaxCoverage(games, config) {
+		return cappedCoverageWeight
+	}
+	if config.MinCoverage > 0 && games < config.MinCoverage {
+		return 1.0 + float64(config.MinCoverage-games)/float64(config.MinCoverage)
+	}
+	return 1.0
+}
+
+// reachedMaxCoverage reports whether a proposal has been compared MaxCoverage times
+func reachedMaxCoverage(games int, config OptimizationConfig) bool {
+	return config.MaxCoverage > 0 && games >= config.MaxCoverage
+}
+
+// isCalibrationTurn reports whether the comparison with the given index should calibrate across bins.
+// Turns are spread over blocks of 20 so a rate of 0.15 yields 3 calibration turns per block.
+func isCalibrationTurn(comparisonIndex int, crossBinRate float64) bool {
+	const block = 20
+	return float64(comparisonIndex%block) < crossBinRate*block
+}
+
+// sortedBinIndices returns bin indices in ascending rating order
+func sortedBinIndices(bins map[int][]string) []int {
+	indices := make([]int, 0, len(bins))
+	for binIndex := range bins {
+		indices = append(indices, binIndex)
+	}
+	sort.Ints(indices)
+	return indices
+}
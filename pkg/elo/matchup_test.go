@@ -0,0 +1,192 @@
+package elo
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createUniformRatings(count int, score float64) []Rating {
+	ratings := make([]Rating, count)
+	for i := range ratings {
+		ratings[i] = Rating{ID: fmt.Sprintf("p%02d", i+1), Score: score}
+	}
+	return ratings
+}
+
+func TestInformationGainStrategy(t *testing.T) {
+	engine := createTestEngine()
+	config := DefaultOptimizationConfig()
+	config.CrossBinRate = 0 // Disable calibration unless a test enables it
+	strategy := NewInformationGainStrategy(engine, config)
+
+	t.Run("prefers under-compared proposals", func(t *testing.T) {
+		ratings := createUniformRatings(6, 1500)
+		ratings[0].Games = 4
+		ratings[1].Games = 4
+
+		group := strategy.NextGroup(ratings, NewComparisonHistory(), 2)
+		require.Len(t, group, 2)
+		assert.NotContains(t, group, "p01")
+		assert.NotContains(t, group, "p02")
+	})
+
+	t.Run("prefers close ratings", func(t *testing.T) {
+		ratings := []Rating{
+			{ID: "a", Score: 1200},
+			{ID: "b", Score: 1500},
+			{ID: "c", Score: 1510},
+			{ID: "d", Score: 1800},
+		}
+
+		group := strategy.NextGroup(ratings, NewComparisonHistory(), 2)
+		assert.ElementsMatch(t, []string{"b", "c"}, group)
+	})
+
+	t.Run("avoids repeating compared pairs", func(t *testing.T) {
+		ratings := createUniformRatings(4, 1500)
+		history := NewComparisonHistory()
+		history.RecordPairs("p01", "p02")
+		history.RecordPairs("p01", "p02")
+
+		group := strategy.NextGroup(ratings, history, 2)
+		require.Len(t, group, 2)
+		assert.False(t, group[0] == "p01" && group[1] == "p02")
+	})
+
+	t.Run("skips proposals at max coverage", func(t *testing.T) {
+		ratings := createUniformRatings(4, 1500)
+		for i := range ratings {
+			ratings[i].Games = config.MaxCoverage
+		}
+		assert.Nil(t, strategy.NextGroup(ratings, NewComparisonHistory(), 2))
+
+		ratings[3].Games = 0
+		group := strategy.NextGroup(ratings, NewComparisonHistory(), 2)
+		require.Len(t, group, 2)
+		assert.Contains(t, group, "p04")
+	})
+
+	t.Run("builds multi-way groups of close proposals", func(t *testing.T) {
+		ratings := []Rating{
+			{ID: "low", Score: 1000},
+			{ID: "m1", Score: 1500},
+			{ID: "m2", Score: 1505},
+			{ID: "m3", Score: 1510},
+			{ID: "m4", Score: 1515},
+			{ID: "high", Score: 2000},
+		}
+
+		trio := strategy.NextGroup(ratings, NewComparisonHistory(), 3)
+		require.Len(t, trio, 3)
+		assert.NotContains(t, trio, "low")
+		assert.NotContains(t, trio, "high")
+
+		quartet := strategy.NextGroup(ratings, NewComparisonHistory(), 4)
+		assert.ElementsMatch(t, []string{"m1", "m2", "m3", "m4"}, quartet)
+	})
+
+	t.Run("cross-bin calibration honours rate", func(t *testing.T) {
+		calibrating := config
+		calibrating.CrossBinRate = 0.15
+		ratings := []Rating{
+			{ID: "a1", Score: 1000},
+			{ID: "a2", Score: 1005},
+			{ID: "b1", Score: 1500},
+			{ID: "b2", Score: 1505},
+			{ID: "c1", Score: 2000},
+			{ID: "c2", Score: 2005},
+		}
+
+		bins := engine.GetRatingBins(ratings, calibrating.BinSize)
+		binOf := make(map[string]int)
+		for binIndex, ids := range bins {
+			for _, id := range ids {
+				binOf[id] = binIndex
+			}
+		}
+
+		history := NewComparisonHistory()
+		calibrationTurns := 0
+		for turn := 0; turn < 20; turn++ {
+			matchup := engine.GetOptimalMatchup(ratings, history, calibrating)
+			require.NotNil(t, matchup)
+			if binOf[matchup.ProposalA] != binOf[matchup.ProposalB] {
+				calibrationTurns++
+			}
+			history.AddComparison(ComparisonResult{Method: Pairwise})
+		}
+
+		assert.Equal(t, 3, calibrationTurns)
+	})
+
+	t.Run("handles too few proposals", func(t *testing.T) {
+		assert.Nil(t, strategy.NextGroup(createUniformRatings(2, 1500), nil, 3))
+		assert.Nil(t, strategy.NextGroup(nil, nil, 2))
+	})
+}
+
+func TestSequentialStrategy(t *testing.T) {
+	strategy := SequentialStrategy{}
+	ratings := createUniformRatings(4, 1500)
+
+	t.Run("walks pairs in input order", func(t *testing.T) {
+		history := NewComparisonHistory()
+		assert.Equal(t, []string{"p01", "p02"}, strategy.NextGroup(ratings, history, 2))
+
+		history.RecordPairs("p01", "p02")
+		assert.Equal(t, []string{"p01", "p03"}, strategy.NextGroup(ratings, history, 2))
+	})
+
+	t.Run("returns nil when all pairs compared", func(t *testing.T) {
+		history := NewComparisonHistory()
+		history.RecordPairs("p01", "p02", "p03", "p04")
+		assert.Nil(t, strategy.NextGroup(ratings, history, 2))
+	})
+
+	t.Run("round-robin groups", func(t *testing.T) {
+		history := NewComparisonHistory()
+		assert.Equal(t, []string{"p01", "p02", "p03"}, strategy.NextGroup(ratings, history, 3))
+
+		history.AddComparison(ComparisonResult{Method: Trio})
+		assert.Equal(t, []string{"p02", "p03", "p04"}, strategy.NextGroup(ratings, history, 3))
+	})
+}
+
+func TestGroupedStrategy(t *testing.T) {
+	ratings := createUniformRatings(6, 1500)
+	groups := map[string]string{"p01": "dev", "p02": "ops", "p03": "dev", "p04": "ops", "p05": "dev", "p06": "community"}
+
+	t.Run("compares within the least compared group", func(t *testing.T) {
+		strategy := NewGroupedStrategy(SequentialStrategy{}, groups, 0)
+		assert.Equal(t, []string{"p01", "p03"}, strategy.NextGroup(ratings, NewComparisonHistory(), 2))
+
+		compared := append([]Rating{}, ratings...)
+		compared[0].Games, compared[2].Games = 2, 2
+		assert.Equal(t, []string{"p02", "p04"}, strategy.NextGroup(compared, NewComparisonHistory(), 2))
+
+		// Only dev has enough proposals for a trio
+		assert.Equal(t, []string{"p01", "p03", "p05"}, strategy.NextGroup(ratings, NewComparisonHistory(), 3))
+	})
+
+	t.Run("falls back to all proposals when groups are exhausted", func(t *testing.T) {
+		strategy := NewGroupedStrategy(SequentialStrategy{}, groups, 0)
+		history := NewComparisonHistory()
+		history.RecordPairs("p01", "p03", "p05")
+		history.RecordPairs("p02", "p04")
+
+		assert.Equal(t, []string{"p01", "p02"}, strategy.NextGroup(ratings, history, 2))
+	})
+
+	t.Run("calibration turns span groups", func(t *testing.T) {
+		strategy := NewGroupedStrategy(SequentialStrategy{}, groups, 1)
+		assert.Equal(t, []string{"p01", "p02"}, strategy.NextGroup(ratings, NewComparisonHistory(), 2))
+	})
+
+	t.Run("without groups uses the base strategy", func(t *testing.T) {
+		strategy := NewGroupedStrategy(SequentialStrategy{}, nil, 0)
+		assert.Equal(t, []string{"p01", "p02"}, strategy.NextGroup(ratings, nil, 2))
+	})
+}
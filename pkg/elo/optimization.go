@@ -69,6 +69,10 @@ type OptimizationConfig struct {
 	TopNForStability   int     // top N positions to track for ranking stability (default: 5)
 	CrossBinRate       float64 // rate of cross-bin calibration (default: 0.15)
 	ConvergenceWindow  int     // window for convergence analysis (default: 20)
+
+	MinComparisons      int     // comparisons before stopping can be recommended (default: 0)
+	MaxComparisons      int     // hard limit that always recommends stopping (0 = no limit)
+	ConfidenceThreshold float64 // share of criteria that must be met to stop (default: 0.75)
 }
 
 // DefaultOptimizationConfig returns recommended optimization settings
@@ -82,6 +86,8 @@ func DefaultOptimizationConfig() OptimizationConfig {
 		TopNForStability:   5,
 		CrossBinRate:       0.15,
 		ConvergenceWindow:  20,
+
+		ConfidenceThreshold: 0.75,
 	}
 }
 
@@ -129,6 +135,16 @@ func (ch *ComparisonHistory) AddComparison(result ComparisonResult) {
 	}
 }
 
+// RecordPairs counts every pair of the given proposals as compared once
+// (used when rebuilding history from comparisons that carry no rating updates)
+func (ch *ComparisonHistory) RecordPairs(proposalIDs ...string) {
+	for i := 0; i < len(proposalIDs); i++ {
+		for j := i + 1; j < len(proposalIDs); j++ {
+			ch.PairHistory[createPairKey(proposalIDs[i], proposalIDs[j])]++
+		}
+	}
+}
+
 // GetRecentComparisons returns the most recent N comparisons
 func (ch *ComparisonHistory) GetRecentComparisons(n int) []ComparisonResult {
 	if n <= 0 {
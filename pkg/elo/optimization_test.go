@@ -241,6 +241,100 @@ func TestCheckConvergence(t *testing.T) {
 	})
 }
 
+func TestCheckConvergenceStoppingRules(t *testing.T) {
+	engine := createTestEngine()
+	proposals := createOptimizationTestRatings()
+
+	// stableHistory holds n comparisons with tiny rating changes covering every proposal
+	stableHistory := func(n int) *ComparisonHistory {
+		history := NewComparisonHistory()
+		for i := 0; i < n; i++ {
+			a, b := proposals[i%len(proposals)], proposals[(i+1)%len(proposals)]
+			history.AddComparison(ComparisonResult{
+				Updates: []RatingUpdate{
+					{ProposalID: a.ID, OldRating: a.Score, NewRating: a.Score, Delta: 1.0},
+					{ProposalID: b.ID, OldRating: b.Score, NewRating: b.Score, Delta: -1.0},
+				},
+				Method: Pairwise,
+			})
+		}
+		return history
+	}
+
+	t.Run("all criteria met", func(t *testing.T) {
+		status := engine.CheckConvergence(proposals, stableHistory(30), DefaultOptimizationConfig())
+
+		assert.True(t, status.ShouldStop)
+		assert.Equal(t, 1.0, status.Confidence)
+		assert.Zero(t, status.RemainingEstimate)
+		assert.Len(t, status.CriteriaMet, 4)
+	})
+
+	t.Run("minimum comparisons", func(t *testing.T) {
+		config := DefaultOptimizationConfig()
+		config.MinComparisons = 40
+
+		status := engine.CheckConvergence(proposals, stableHistory(30), config)
+		assert.False(t, status.ShouldStop)
+		assert.Equal(t, 1.0, status.Confidence)
+		assert.Equal(t, 10, status.RemainingEstimate)
+	})
+
+	t.Run("confidence threshold", func(t *testing.T) {
+		config := DefaultOptimizationConfig()
+		config.MinCoverage = 100 // Coverage can not be met
+
+		status := engine.CheckConvergence(proposals, stableHistory(30), config)
+		assert.False(t, status.CriteriaMet["minimum_coverage"])
+		assert.Equal(t, 0.75, status.Confidence)
+		assert.True(t, status.ShouldStop)
+
+		config.ConfidenceThreshold = 0.8
+		status = engine.CheckConvergence(proposals, stableHistory(30), config)
+		assert.False(t, status.ShouldStop)
+		assert.Greater(t, status.RemainingEstimate, 0)
+	})
+
+	t.Run("maximum comparisons", func(t *testing.T) {
+		config := DefaultOptimizationConfig()
+		config.MinCoverage = 100
+		config.ConfidenceThreshold = 1.0
+		config.MaxComparisons = 30
+
+		status := engine.CheckConvergence(proposals, stableHistory(29), config)
+		assert.False(t, status.ShouldStop)
+		assert.Equal(t, 1, status.RemainingEstimate)
+
+		status = engine.CheckConvergence(proposals, stableHistory(30), config)
+		assert.True(t, status.ShouldStop)
+	})
+}
+
+func TestRankingStabilityReplaysHistory(t *testing.T) {
+	engine := createTestEngine()
+	proposals := createOptimizationTestRatings()
+	config := DefaultOptimizationConfig()
+	config.StabilityWindow = 4
+	config.TopNForStability = 2
+
+	history := NewComparisonHistory()
+	for i := 0; i < 3; i++ {
+		history.AddComparison(ComparisonResult{Updates: []RatingUpdate{{ProposalID: "low1", Delta: 1.0}}})
+	}
+	// The last comparison lifted mid1 into the top 2
+	history.AddComparison(ComparisonResult{Updates: []RatingUpdate{{ProposalID: "mid1", Delta: 240.0}}})
+	current := make([]Rating, len(proposals))
+	copy(current, proposals)
+	current[2].Score += 240.0
+
+	previous := engine.reconstructRatingsAtComparison(current, history, 1)
+	assert.Equal(t, 1450.0, previous[2].Score)
+	assert.Equal(t, 1200.0-1.0, previous[4].Score)
+
+	// Only the final state matches the current top 2
+	assert.Equal(t, 0.25, engine.calculateRankingStability(current, history, config))
+}
+
 func TestGetProgressMetrics(t *testing.T) {
 	engine := createTestEngine()
 	proposals := createOptimizationTestRatings()
@@ -0,0 +1,61 @@
+package elo
+
+import (
+	"fmt"
+)
+
+// RatingSystem identifies the algorithm used to update proposal ratings
+type RatingSystem string
+
+// Supported rating systems
+const (
+	SystemElo     RatingSystem = "elo"     // Classic fixed-K Elo
+	SystemGlicko2 RatingSystem = "glicko2" // Glicko-2 with rating deviation and volatility
+)
+
+// RatingEngine is the common interface of all rating algorithms
+type RatingEngine interface {
+	// System returns the rating system implemented by the engine
+	System() RatingSystem
+	// NewRating returns the starting rating of a proposal
+	NewRating(id string) Rating
+	// CalculatePairwise returns updated ratings of the winner and the loser
+	CalculatePairwise(winner, loser Rating) (Rating, Rating, error)
+	// CalculateDraw returns updated ratings of two proposals judged equally good
+	CalculateDraw(a, b Rating) (Rating, Rating, error)
+	// CalculateMultiway returns updated ratings of proposals ranked from best to worst;
+	// optional positions mark tied proposals
+	CalculateMultiway(rankings []Rating, positions ...int) ([]Rating, ComparisonResult, error)
+	// CalculatePartial returns updated ratings of proposals in a partial order, where
+	// proposals sharing a position were not ordered against each other
+	CalculatePartial(rankings []Rating, positions []int) ([]Rating, ComparisonResult, error)
+	// Replay applies comparison outcomes to ratings
+	Replay(ratings []Rating, outcomes []Outcome) ([]Rating, error)
+}
+
+// NewRatingEngine creates the engine for a rating system (empty selects Elo).
+// Glicko-2 uses the initial rating and bounds from config with its default deviation, volatility and tau.
+func NewRatingEngine(system RatingSystem, config Config) (RatingEngine, error) {
+	switch system {
+	case "", SystemElo:
+		return NewEngine(config)
+	case SystemGlicko2:
+		glickoConfig := DefaultGlicko2Config()
+		glickoConfig.InitialRating = config.InitialRating
+		glickoConfig.MinRating = config.MinRating
+		glickoConfig.MaxRating = config.MaxRating
+		return NewGlicko2Engine(glickoConfig)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownRatingSystem, system)
+	}
+}
+
+// System returns SystemElo
+func (e *Engine) System() RatingSystem {
+	return SystemElo
+}
+
+// NewRating returns a rating at the engine's initial rating
+func (e *Engine) NewRating(id string) Rating {
+	return Rating{ID: id, Score: e.InitialRating}
+}
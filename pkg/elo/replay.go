@@ -0,0 +1,141 @@
+package elo
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrUnknownProposal is returned when a replayed outcome references a proposal without a rating
+var ErrUnknownProposal = errors.New("outcome references unknown proposal")
+
+// Outcome is the result of a single comparison as used by Replay and FitPlackettLuce
+type Outcome struct {
+	Ranking   []string // Proposal IDs ordered from best to worst
+	Positions []int    // Optional 0-based finishing positions; equal positions are tied
+	Unordered bool     // Proposals sharing a position were not ordered rather than tied (a partial ranking)
+}
+
+// NewOutcome creates an outcome without ties from proposal IDs ordered best to worst
+func NewOutcome(ranking ...string) Outcome {
+	return Outcome{Ranking: ranking}
+}
+
+// NewDrawOutcome creates an outcome in which all proposals were judged equally good
+func NewDrawOutcome(ids ...string) Outcome {
+	return Outcome{Ranking: ids, Positions: make([]int, len(ids))}
+}
+
+// NewTopOutcome creates a partial outcome in which only the first top proposals were
+// ordered and the remaining ones were left unordered below them ("rank top 3 of 6")
+func NewTopOutcome(top int, ranking ...string) Outcome {
+	return Outcome{Ranking: ranking, Positions: TopPositions(len(ranking), top), Unordered: true}
+}
+
+// NewPickOutcome creates a partial outcome in which the first picked proposals were
+// preferred over the remaining ones without ordering either group ("pick the best 3 of 6")
+func NewPickOutcome(picked int, ids ...string) Outcome {
+	return Outcome{Ranking: ids, Positions: PickPositions(len(ids), picked), Unordered: true}
+}
+
+// TopPositions returns the positions of count proposals of which the first top are
+// ordered and the others share the next position
+func TopPositions(count, top int) []int {
+	positions := make([]int, count)
+	for i := range positions {
+		positions[i] = min(i, top)
+	}
+	return positions
+}
+
+// PickPositions returns the positions of count proposals of which the first picked
+// share the first position and the others share the position after them
+func PickPositions(count, picked int) []int {
+	positions := make([]int, count)
+	for i := picked; i < count; i++ {
+		positions[i] = picked
+	}
+	return positions
+}
+
+// IsDraw reports whether all proposals of the outcome share the first position
+func (o Outcome) IsDraw() bool {
+	if o.Unordered || len(o.Positions) != len(o.Ranking) || len(o.Positions) == 0 {
+		return false
+	}
+	for _, position := range o.Positions {
+		if position != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Replay applies a sequence of comparison outcomes to a set of starting ratings.
+// Outcomes with two proposals are replayed as a pairwise game (or a draw when tied),
+// larger ones as a multi-way comparison and unordered ones as a partial comparison.
+// The input ratings are not modified; updated ratings are returned in input order.
+func (e *Engine) Replay(ratings []Rating, outcomes []Outcome) ([]Rating, error) {
+	return replayOutcomes(e, ratings, outcomes)
+}
+
+// replayOutcomes replays outcomes with any rating engine
+func replayOutcomes(engine RatingEngine, ratings []Rating, outcomes []Outcome) ([]Rating, error) {
+	replayed := make([]Rating, len(ratings))
+	copy(replayed, ratings)
+
+	index := make(map[string]int, len(replayed))
+	for i, rating := range replayed {
+		index[rating.ID] = i
+	}
+
+	for n, outcome := range outcomes {
+		if len(outcome.Positions) > 0 {
+			if err := validatePositions(outcome.Positions, len(outcome.Ranking)); err != nil {
+				return nil, fmt.Errorf("outcome %d: %w", n, err)
+			}
+		}
+
+		ranked := make([]Rating, len(outcome.Ranking))
+		for i, id := range outcome.Ranking {
+			idx, exists := index[id]
+			if !exists {
+				return nil, fmt.Errorf("%w: %s in outcome %d", ErrUnknownProposal, id, n)
+			}
+			ranked[i] = replayed[idx]
+		}
+
+		var updated []Rating
+		switch {
+		case len(ranked) < 2:
+			return nil, fmt.Errorf("outcome %d: %w", n, ErrTooFewProposals)
+		case outcome.Unordered:
+			var err error
+			updated, _, err = engine.CalculatePartial(ranked, outcome.Positions)
+			if err != nil {
+				return nil, fmt.Errorf("outcome %d: %w", n, err)
+			}
+		case len(ranked) == 2:
+			calculate := engine.CalculatePairwise
+			if outcome.IsDraw() {
+				calculate = engine.CalculateDraw
+			}
+			winner, loser, err := calculate(ranked[0], ranked[1])
+			if err != nil {
+				return nil, fmt.Errorf("outcome %d: %w", n, err)
+			}
+			updated = []Rating{winner, loser}
+		default:
+			var err error
+			updated, _, err = engine.CalculateMultiway(ranked, outcome.Positions...)
+			if err != nil {
+				return nil, fmt.Errorf("outcome %d: %w", n, err)
+			}
+		}
+
+		for _, rating := range updated {
+			replayed[index[rating.ID]] = rating
+		}
+	}
+
+	return replayed, nil
+}
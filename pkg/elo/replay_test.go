@@ -0,0 +1,104 @@
+package elo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// toOutcomes converts rankings without ties into replay outcomes
+func toOutcomes(rankings [][]string) []Outcome {
+	outcomes := make([]Outcome, len(rankings))
+	for i, ranking := range rankings {
+		outcomes[i] = NewOutcome(ranking...)
+	}
+	return outcomes
+}
+
+func TestEngineReplay(t *testing.T) {
+	engine := createTestEngine()
+
+	t.Run("matches step by step calculation", func(t *testing.T) {
+		ratings := createUniformRatings(4, 1500)
+
+		replayed, err := engine.Replay(ratings, toOutcomes([][]string{
+			{"p01", "p02"},
+			{"p03", "p01", "p04"},
+		}))
+		require.NoError(t, err)
+		require.Len(t, replayed, 4)
+
+		winner, loser, err := engine.CalculatePairwise(ratings[0], ratings[1])
+		require.NoError(t, err)
+		trio, _, err := engine.CalculateMultiway([]Rating{ratings[2], winner, ratings[3]})
+		require.NoError(t, err)
+
+		assert.Equal(t, trio[1], replayed[0])
+		assert.Equal(t, loser, replayed[1])
+		assert.Equal(t, trio[0], replayed[2])
+		assert.Equal(t, trio[2], replayed[3])
+	})
+
+	t.Run("replays draws and tied positions", func(t *testing.T) {
+		ratings := []Rating{{ID: "p01", Score: 1600}, {ID: "p02", Score: 1400}, {ID: "p03", Score: 1500}}
+
+		tied := Outcome{Ranking: []string{"p01", "p02", "p03"}, Positions: []int{0, 0, 2}}
+		replayed, err := engine.Replay(ratings, []Outcome{NewDrawOutcome("p01", "p02"), tied})
+		require.NoError(t, err)
+
+		a, b, err := engine.CalculateDraw(ratings[0], ratings[1])
+		require.NoError(t, err)
+		trio, _, err := engine.CalculateMultiway([]Rating{a, b, ratings[2]}, 0, 0, 2)
+		require.NoError(t, err)
+		assert.Equal(t, trio, replayed)
+
+		_, err = engine.Replay(ratings, []Outcome{{Ranking: []string{"p01", "p02"}, Positions: []int{0}}})
+		assert.ErrorIs(t, err, ErrInvalidPositions)
+	})
+
+	t.Run("replays partial rankings", func(t *testing.T) {
+		ratings := createUniformRatings(6, 1500)
+		ids := []string{"p04", "p01", "p06", "p02", "p03", "p05"}
+		ranked := []Rating{ratings[3], ratings[0], ratings[5], ratings[1], ratings[2], ratings[4]}
+
+		replayed, err := engine.Replay(ratings, []Outcome{NewTopOutcome(2, ids...)})
+		require.NoError(t, err)
+		expected, _, err := engine.CalculatePartial(ranked, TopPositions(6, 2))
+		require.NoError(t, err)
+		assert.Equal(t, expected[0], replayed[3])
+		assert.Equal(t, expected[5], replayed[4])
+
+		// Picked proposals are not a draw even when all of them are picked
+		assert.False(t, NewPickOutcome(2, "p01", "p02").IsDraw())
+		_, err = engine.Replay(ratings, []Outcome{NewPickOutcome(6, ids...)})
+		assert.ErrorIs(t, err, ErrNoOrderedPairs)
+	})
+
+	t.Run("does not modify input ratings", func(t *testing.T) {
+		ratings := createUniformRatings(2, 1500)
+
+		_, err := engine.Replay(ratings, toOutcomes([][]string{{"p02", "p01"}}))
+		require.NoError(t, err)
+		assert.Equal(t, 1500.0, ratings[0].Score)
+		assert.Equal(t, 0, ratings[1].Games)
+	})
+
+	t.Run("no outcomes keeps ratings", func(t *testing.T) {
+		ratings := createUniformRatings(3, 1500)
+
+		replayed, err := engine.Replay(ratings, nil)
+		require.NoError(t, err)
+		assert.Equal(t, ratings, replayed)
+	})
+
+	t.Run("rejects invalid outcomes", func(t *testing.T) {
+		ratings := createUniformRatings(2, 1500)
+
+		_, err := engine.Replay(ratings, toOutcomes([][]string{{"p01", "missing"}}))
+		assert.ErrorIs(t, err, ErrUnknownProposal)
+
+		_, err = engine.Replay(ratings, toOutcomes([][]string{{"p01"}}))
+		assert.ErrorIs(t, err, ErrTooFewProposals)
+	})
+}
@@ -0,0 +1,205 @@
+package elo
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// ErrInvalidSimulation is returned for simulation settings that can not be run
+var ErrInvalidSimulation = errors.New("invalid simulation")
+
+// SimulationConfig describes a simulated ranking session
+type SimulationConfig struct {
+	Strategy    MatchupStrategy    // Strategy selecting the proposals of each comparison
+	GroupSize   int                // Proposals per comparison: 2 (pairwise), 3 (trio) or 4 (quartet)
+	Comparisons int                // Number of comparisons to simulate
+	Step        int                // Comparisons between two accuracy measurements
+	Noise       float64            // Standard deviation of the reviewer's perception, in rating points
+	TopT        int                // Size of the top set whose precision is measured
+	Convergence OptimizationConfig // Stopping criteria checked after every comparison
+	Seed        int64              // Seed of the reviewer's noise
+}
+
+// SimulationPoint is the accuracy of the ranking after a number of comparisons
+type SimulationPoint struct {
+	Comparisons   int     // Comparisons made so far
+	KendallTau    float64 // Rank correlation with the true order (-1.0-1.0)
+	TopTPrecision float64 // Share of the true top T found in the ranked top T (0.0-1.0)
+}
+
+// SimulationResult is the outcome of a simulated ranking session
+type SimulationResult struct {
+	Points    []SimulationPoint // Accuracy every Step comparisons and after the last one
+	StoppedAt int               // Comparisons after which stopping was first recommended (0 = never)
+	AtStop    SimulationPoint   // Accuracy when stopping was first recommended
+	Exhausted bool              // Whether the strategy ran out of comparisons before the end
+}
+
+// Simulate runs a ranking session against a reviewer who perceives the true strength of each
+// proposal with Gaussian noise and ranks the presented proposals by what they perceive.
+// The truth lists the proposals in input order with their true strength as Score; every
+// proposal starts at the engine's initial rating.
+func (e *Engine) Simulate(truth []Rating, config SimulationConfig) (*SimulationResult, error) {
+	if config.Strategy == nil {
+		return nil, fmt.Errorf("%w: matchup strategy is required", ErrInvalidSimulation)
+	}
+	if config.GroupSize < 2 || config.GroupSize > len(truth) {
+		return nil, fmt.Errorf("%w: group size %d for %d proposals", ErrInvalidSimulation, config.GroupSize, len(truth))
+	}
+	if config.Comparisons <= 0 || config.Step <= 0 {
+		return nil, fmt.Errorf("%w: comparisons and step must be positive", ErrInvalidSimulation)
+	}
+
+	strengths := make(map[string]float64, len(truth))
+	ratings := make([]Rating, len(truth))
+	index := make(map[string]int, len(truth))
+	for i, proposal := range truth {
+		strengths[proposal.ID] = proposal.Score
+		ratings[i] = e.NewRating(proposal.ID)
+		index[proposal.ID] = i
+	}
+	trueOrder := rankedIDs(truth)
+
+	reviewer := rand.New(rand.NewSource(config.Seed))
+	history := NewComparisonHistory()
+	result := &SimulationResult{Points: make([]SimulationPoint, 0, config.Comparisons/config.Step+1)}
+
+	measure := func() SimulationPoint {
+		order := rankedIDs(ratings)
+		return SimulationPoint{
+			Comparisons:   len(history.Comparisons),
+			KendallTau:    KendallTau(trueOrder, order),
+			TopTPrecision: TopTPrecision(trueOrder, order, config.TopT),
+		}
+	}
+
+	for len(history.Comparisons) < config.Comparisons {
+		ids := config.Strategy.NextGroup(ratings, history, config.GroupSize)
+		if len(ids) != config.GroupSize {
+			result.Exhausted = true
+			break
+		}
+
+		// The reviewer ranks the presented proposals by their perceived strength
+		perceived := make(map[string]float64, len(ids))
+		for _, id := range ids {
+			perceived[id] = strengths[id] + reviewer.NormFloat64()*config.Noise
+		}
+		sort.SliceStable(ids, func(i, j int) bool { return perceived[ids[i]] > perceived[ids[j]] })
+
+		presented := make([]Rating, len(ids))
+		for i, id := range ids {
+			presented[i] = ratings[index[id]]
+		}
+
+		var comparison ComparisonResult
+		var err error
+		if len(presented) == 2 {
+			comparison, err = e.CalculatePairwiseWithResult(presented[0], presented[1])
+		} else {
+			_, comparison, err = e.CalculateMultiway(presented)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("simulated comparison %d: %w", len(history.Comparisons)+1, err)
+		}
+
+		for _, update := range comparison.Updates {
+			rating := &ratings[index[update.ProposalID]]
+			rating.Score = update.NewRating
+			rating.Games++
+		}
+		history.AddComparison(comparison)
+
+		if result.StoppedAt == 0 && e.CheckConvergence(ratings, history, config.Convergence).ShouldStop {
+			result.StoppedAt = len(history.Comparisons)
+			result.AtStop = measure()
+		}
+		if len(history.Comparisons)%config.Step == 0 {
+			result.Points = append(result.Points, measure())
+		}
+	}
+
+	if last := len(history.Comparisons); last > 0 && last%config.Step != 0 {
+		result.Points = append(result.Points, measure())
+	}
+	return result, nil
+}
+
+// KendallTau returns the Kendall rank correlation of two orderings of the same IDs:
+// 1 for identical orders, -1 for reversed ones. IDs missing from either order are ignored.
+func KendallTau(expected, actual []string) float64 {
+	position := make(map[string]int, len(actual))
+	for i, id := range actual {
+		position[id] = i
+	}
+
+	ranks := make([]int, 0, len(expected))
+	for _, id := range expected {
+		if p, exists := position[id]; exists {
+			ranks = append(ranks, p)
+		}
+	}
+	if len(ranks) < 2 {
+		return 1.0
+	}
+
+	concordant, discordant := 0, 0
+	for i := 0; i < len(ranks); i++ {
+		for j := i + 1; j < len(ranks); j++ {
+			if ranks[i] < ranks[j] {
+				concordant++
+			} else {
+				discordant++
+			}
+		}
+	}
+	return float64(concordant-discordant) / float64(concordant+discordant)
+}
+
+// TopTPrecision returns the share of the expected top t that is also in the actual top t
+func TopTPrecision(expected, actual []string, t int) float64 {
+	t = min(t, min(len(expected), len(actual)))
+	if t <= 0 {
+		return 0.0
+	}
+
+	top := make(map[string]bool, t)
+	for _, id := range expected[:t] {
+		top[id] = true
+	}
+
+	found := 0
+	for _, id := range actual[:t] {
+		if top[id] {
+			found++
+		}
+	}
+	return float64(found) / float64(t)
+}
+
+// rankedIDs returns the IDs ordered by score, highest first (ties keep their order)
+func rankedIDs(ratings []Rating) []string {
+	sorted := make([]Rating, len(ratings))
+	copy(sorted, ratings)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Score > sorted[j].Score })
+
+	ids := make([]string, len(sorted))
+	for i, rating := range sorted {
+		ids[i] = rating.ID
+	}
+	return ids
+}
+
+// TrueStrengths draws a hidden true strength for every proposal, normally distributed
+// around the engine's initial rating with the given spread (standard deviation)
+func (e *Engine) TrueStrengths(ids []string, spread float64, seed int64) []Rating {
+	random := rand.New(rand.NewSource(seed))
+	truth := make([]Rating, len(ids))
+	for i, id := range ids {
+		truth[i] = Rating{ID: id, Score: e.InitialRating + random.NormFloat64()*math.Abs(spread)}
+	}
+	return truth
+}
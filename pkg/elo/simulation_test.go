@@ -0,0 +1,93 @@
+package elo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKendallTau(t *testing.T) {
+	order := []string{"a", "b", "c", "d"}
+
+	assert.Equal(t, 1.0, KendallTau(order, order))
+	assert.Equal(t, -1.0, KendallTau(order, []string{"d", "c", "b", "a"}))
+	assert.InDelta(t, 4.0/6.0, KendallTau(order, []string{"b", "a", "c", "d"}), 1e-9)
+	assert.Equal(t, 1.0, KendallTau(order, []string{"a", "x", "d"}), "unknown IDs are ignored")
+	assert.Equal(t, 1.0, KendallTau(nil, order))
+}
+
+func TestTopTPrecision(t *testing.T) {
+	expected := []string{"a", "b", "c", "d"}
+
+	assert.Equal(t, 1.0, TopTPrecision(expected, []string{"b", "a", "d", "c"}, 2))
+	assert.Equal(t, 0.5, TopTPrecision(expected, []string{"a", "c", "b", "d"}, 2))
+	assert.Equal(t, 1.0, TopTPrecision(expected, expected, 10), "t is capped by the number of proposals")
+	assert.Equal(t, 0.0, TopTPrecision(expected, expected, 0))
+}
+
+func TestSimulate(t *testing.T) {
+	engine := createTestEngine()
+	ids := []string{"p1", "p2", "p3", "p4", "p5", "p6", "p7", "p8"}
+	truth := engine.TrueStrengths(ids, 300, 3)
+
+	config := SimulationConfig{
+		Strategy:    NewInformationGainStrategy(engine, DefaultOptimizationConfig()),
+		GroupSize:   2,
+		Comparisons: 30,
+		Step:        7,
+		TopT:        3,
+		Convergence: DefaultOptimizationConfig(),
+		Seed:        3,
+	}
+
+	t.Run("noiseless reviewer", func(t *testing.T) {
+		result, err := engine.Simulate(truth, config)
+		require.NoError(t, err)
+
+		counts := make([]int, len(result.Points))
+		for i, point := range result.Points {
+			counts[i] = point.Comparisons
+		}
+		assert.Equal(t, []int{7, 14, 21, 28, 30}, counts)
+		assert.False(t, result.Exhausted)
+
+		last := result.Points[len(result.Points)-1]
+		assert.Greater(t, last.KendallTau, 0.5)
+		assert.Greater(t, last.KendallTau, result.Points[0].KendallTau)
+	})
+
+	t.Run("multi-way comparisons", func(t *testing.T) {
+		config.GroupSize = 4
+		config.Noise = 50
+		result, err := engine.Simulate(truth, config)
+		require.NoError(t, err)
+		assert.Greater(t, result.Points[len(result.Points)-1].KendallTau, 0.0)
+	})
+
+	t.Run("stopping point", func(t *testing.T) {
+		config.Convergence.MaxComparisons = 12
+		result, err := engine.Simulate(truth, config)
+		require.NoError(t, err)
+		assert.Equal(t, 12, result.StoppedAt)
+		assert.Equal(t, 12, result.AtStop.Comparisons)
+	})
+
+	t.Run("exhausted strategy", func(t *testing.T) {
+		config.Strategy = SequentialStrategy{}
+		config.GroupSize = 2
+		config.Comparisons = 100
+		result, err := engine.Simulate(truth, config)
+		require.NoError(t, err)
+		assert.True(t, result.Exhausted)
+		assert.Equal(t, 28, result.Points[len(result.Points)-1].Comparisons) // Every pair once
+	})
+
+	t.Run("invalid settings", func(t *testing.T) {
+		_, err := engine.Simulate(truth, SimulationConfig{GroupSize: 2, Comparisons: 1, Step: 1})
+		assert.ErrorIs(t, err, ErrInvalidSimulation)
+
+		_, err = engine.Simulate(truth[:3], SimulationConfig{Strategy: SequentialStrategy{}, GroupSize: 4, Comparisons: 1, Step: 1})
+		assert.ErrorIs(t, err, ErrInvalidSimulation)
+	})
+}
@@ -6,6 +6,8 @@ package tui
 import (
 	"context"
 	"fmt"
+	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
@@ -23,6 +25,8 @@ const (
 	ScreenComparison ScreenType = iota
 	// ScreenRanking represents the ranking display screen
 	ScreenRanking
+	// ScreenTriage represents the strong/maybe/weak triage pre-pass screen
+	ScreenTriage
 )
 
 // String returns the string representation of ScreenType
@@ -32,6 +36,8 @@ func (s ScreenType) String() string {
 		return "comparison"
 	case ScreenRanking:
 		return "ranking"
+	case ScreenTriage:
+		return "triage"
 	default:
 		return "unknown"
 	}
@@ -52,6 +58,12 @@ type Screen interface {
 	GetTitle() string
 }
 
+// ProposalFilter is implemented by screens that can narrow the proposals they show
+type ProposalFilter interface {
+	// FilteredProposalIDs returns the IDs of the shown proposals and whether a filter is active
+	FilteredProposalIDs() ([]string, bool)
+}
+
 // AppState represents the current application state
 type AppState struct {
 	mu             sync.RWMutex
@@ -60,6 +72,7 @@ type AppState struct {
 	config         *data.SessionConfig
 	currentScreen  ScreenType
 	previousScreen ScreenType
+	startScreen    ScreenType // Screen shown when the application starts
 	isRunning      bool
 	lastExportTime *time.Time // Track last successful export
 }
@@ -90,6 +103,7 @@ var globalKeyBindings = []KeyBinding{
 	{Key: tcell.KeyCtrlC, Description: "Exit", Handler: (*App).Exit},
 	{Key: tcell.KeyRune, Rune: 'r', Description: "Show rankings", Handler: (*App).ShowRanking},
 	{Key: tcell.KeyRune, Rune: 'c', Description: "Show comparisons", Handler: (*App).ShowComparison},
+	{Key: tcell.KeyCtrlT, Description: "Triage", Handler: (*App).ShowTriage},
 	{Key: tcell.KeyRune, Rune: 'e', Description: "Export to CSV", Handler: (*App).ExportToCSV},
 }
 
@@ -113,6 +127,7 @@ func NewApp(config *data.SessionConfig, storage data.Storage) (*App, error) {
 			config:        config,
 			storage:       storage,
 			currentScreen: ScreenComparison,
+			startScreen:   ScreenComparison,
 			isRunning:     false,
 		},
 		screens: make(map[ScreenType]Screen),
@@ -246,6 +261,18 @@ func (a *App) ShowComparison() error {
 	return a.NavigateTo(ScreenComparison)
 }
 
+// ShowTriage displays the triage screen
+func (a *App) ShowTriage() error {
+	return a.NavigateTo(ScreenTriage)
+}
+
+// SetStartScreen sets the screen shown when the application starts (comparison by default)
+func (a *App) SetStartScreen(screenType ScreenType) {
+	a.state.mu.Lock()
+	defer a.state.mu.Unlock()
+	a.state.startScreen = screenType
+}
+
 // Exit stops the application
 func (a *App) Exit() error {
 	a.state.mu.Lock()
@@ -264,6 +291,7 @@ func (a *App) ExportToCSV() error {
 	session := a.state.session
 	config := a.state.config
 	storage := a.state.storage
+	currentScreen := a.state.currentScreen
 	a.state.mu.RUnlock()
 
 	if session == nil {
@@ -276,13 +304,32 @@ func (a *App) ExportToCSV() error {
 		return fmt.Errorf("no input CSV path stored in session")
 	}
 
-	// Update the original CSV file with export scores
-	err := storage.UpdateCSVScores(session.Proposals, session.InputCSVPath, config.CSV, &config.Elo)
+	// Update the original CSV file with the export scores of every proposal; it is reloaded
+	// on resume, so a filter never leaves rows of it with stale scores
+	proposals := session.GetProposals()
+	err := storage.UpdateCSVScores(proposals, session.InputCSVPath, config.CSV, &session.Config.Elo)
 	if err != nil {
 		a.showErrorDialog("Export Failed", fmt.Sprintf("Failed to export scores to CSV:\n\n%v", err))
 		return fmt.Errorf("failed to export scores to CSV: %w", err)
 	}
 
+	// The proposals a filtered screen shows also go to a separate ranking file
+	a.mu.RLock()
+	filter, filtered := a.screens[currentScreen].(ProposalFilter)
+	a.mu.RUnlock()
+	if filtered {
+		if ids, active := filter.FilteredProposalIDs(); active {
+			filteredFile := FilteredExportFilename(session.InputCSVPath)
+			if err := data.ExportRankingsOf(session, ids, filteredFile, data.ExportFormatCSV); err != nil {
+				a.showErrorDialog("Export Failed", fmt.Sprintf("Failed to export the filtered proposals:\n\n%v", err))
+				return fmt.Errorf("failed to export filtered proposals: %w", err)
+			}
+			a.showMessageDialog("Export Complete", fmt.Sprintf(
+				"Scores of all %d proposals were written to %s.\n\nThe %d proposals shown by the filter were exported to %s.",
+				len(proposals), filepath.Base(session.InputCSVPath), len(ids), filepath.Base(filteredFile)))
+		}
+	}
+
 	// Update last export time on success
 	now := time.Now()
 	a.state.mu.Lock()
@@ -295,16 +342,24 @@ func (a *App) ExportToCSV() error {
 	return nil
 }
 
+// FilteredExportFilename returns the file the proposals shown by a filter are exported to,
+// next to the input CSV (talks.csv becomes talks_filtered.csv)
+func FilteredExportFilename(inputCSV string) string {
+	ext := filepath.Ext(inputCSV)
+	return strings.TrimSuffix(inputCSV, ext) + "_filtered" + ext
+}
+
 // Run starts the TUI application
 func (a *App) Run() error {
 	a.state.mu.Lock()
 	a.state.isRunning = true
+	startScreen := a.state.startScreen
 	a.state.mu.Unlock()
 
-	// Always start with the comparison screen
+	// Start with the comparison screen unless the triage pass was requested
 	// The configuration is now handled via command-line parameters
-	if err := a.NavigateTo(ScreenComparison); err != nil {
-		return fmt.Errorf("failed to navigate to comparison screen: %w", err)
+	if err := a.NavigateTo(startScreen); err != nil {
+		return fmt.Errorf("failed to navigate to %s screen: %w", startScreen.String(), err)
 	}
 
 	// Run the application
@@ -401,6 +456,14 @@ func (a *App) GetComparisonCount(proposalID string) int {
 
 // handleGlobalInput handles global keyboard shortcuts
 func (a *App) handleGlobalInput(event *tcell.EventKey) *tcell.EventKey {
+	// Letters typed into text fields such as the ranking search or a note are not shortcuts
+	switch a.tviewApp.GetFocus().(type) {
+	case *tview.InputField, *tview.TextArea:
+		if event.Key() == tcell.KeyRune {
+			return event
+		}
+	}
+
 	for _, binding := range globalKeyBindings {
 		if (binding.Key != tcell.KeyRune && event.Key() == binding.Key) ||
 			(binding.Key == tcell.KeyRune && event.Rune() == binding.Rune) {
@@ -464,6 +527,21 @@ func (a *App) updateHeader() {
 	a.header.SetText(headerText)
 }
 
+// showMessageDialog displays an informational message in a modal dialog
+func (a *App) showMessageDialog(title, message string) {
+	modal := tview.NewModal().
+		SetText(message).
+		AddButtons([]string{"OK"}).
+		SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+			a.pages.RemovePage("message-dialog")
+		})
+
+	modal.SetTitle(title).
+		SetBorder(true)
+
+	a.pages.AddPage("message-dialog", modal, true, true)
+}
+
 // showErrorDialog displays an error message in a modal dialog
 func (a *App) showErrorDialog(title, message string) {
 	modal := tview.NewModal().
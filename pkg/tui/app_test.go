@@ -3,6 +3,9 @@
 package tui
 
 import (
+	"encoding/csv"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/gdamore/tcell/v2"
@@ -417,6 +420,7 @@ func TestScreenTypeString(t *testing.T) {
 		// ScreenSetup is removed
 		{ScreenComparison, "comparison"},
 		{ScreenRanking, "ranking"},
+		{ScreenTriage, "triage"},
 		{ScreenType(999), "unknown"},
 	}
 
@@ -489,3 +493,58 @@ func BenchmarkAppStateAccess(b *testing.B) {
 		_ = app.GetState()
 	}
 }
+
+// filteringScreen is a mock screen that narrows the proposals it shows
+type filteringScreen struct {
+	*mockScreen
+	ids    []string
+	active bool
+}
+
+func (fs *filteringScreen) FilteredProposalIDs() ([]string, bool) {
+	return fs.ids, fs.active
+}
+
+func TestAppExportRespectsScreenFilter(t *testing.T) {
+	storage := newMockStorage()
+	app, err := NewApp(createTestConfig(), storage)
+	require.NoError(t, err)
+
+	proposals := []data.Proposal{
+		{ID: "1", Title: "Go Generics", Score: 1600},
+		{ID: "2", Title: "Postgres Tuning", Score: 1500},
+		{ID: "3", Title: "Go Concurrency", Score: 1400},
+	}
+	inputCSV := filepath.Join(t.TempDir(), "talks.csv")
+	session, err := data.NewSession("Filter", proposals, data.DefaultSessionConfig(), inputCSV)
+	require.NoError(t, err)
+	app.SetSession(session)
+
+	screen := &filteringScreen{mockScreen: newMockScreen("Rankings"), ids: []string{"3", "1"}, active: true}
+	require.NoError(t, app.RegisterScreen(ScreenRanking, screen))
+	require.NoError(t, app.NavigateTo(ScreenRanking))
+
+	// Every score goes back into the input CSV, the shown proposals also into a separate file
+	require.NoError(t, app.ExportToCSV())
+	assert.Len(t, storage.proposals[inputCSV], 3)
+	assert.True(t, app.pages.HasPage("message-dialog"))
+
+	filteredFile := filepath.Join(filepath.Dir(inputCSV), "talks_filtered.csv")
+	assert.Equal(t, filteredFile, FilteredExportFilename(inputCSV))
+	file, err := os.Open(filteredFile)
+	require.NoError(t, err)
+	defer func() { _ = file.Close() }()
+	records, err := csv.NewReader(file).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	assert.Equal(t, []string{"1", "1"}, records[1][:2])
+	assert.Equal(t, []string{"3", "3"}, records[2][:2])
+
+	// Without an active filter no separate file is written
+	require.NoError(t, os.Remove(filteredFile))
+	screen.active = false
+	require.NoError(t, app.ExportToCSV())
+	assert.Len(t, storage.proposals[inputCSV], 3)
+	_, err = os.Stat(filteredFile)
+	assert.True(t, os.IsNotExist(err))
+}
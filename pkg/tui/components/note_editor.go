@@ -0,0 +1,75 @@
+// Package components provides reusable TUI components for conference talk ranking.
+// This file implements the note editor used to attach free-text reviewer notes
+// to proposals and comparisons.
+package components
+
+import (
+	"github.com/rivo/tview"
+)
+
+// noteLabel is the label of the note text area
+const noteLabel = "Note"
+
+// NoteEditor is a small form editing one multi-line note.
+// Save hands the text to the save callback; Esc or Cancel discards it.
+type NoteEditor struct {
+	form     *tview.Form
+	text     *tview.TextArea
+	onSave   func(note string)
+	onCancel func()
+}
+
+// NewNoteEditor creates a new note editor
+func NewNoteEditor() *NoteEditor {
+	ne := &NoteEditor{
+		form: tview.NewForm(),
+	}
+
+	ne.form.AddTextArea(noteLabel, "", 0, 0, 0, nil).
+		AddButton("Save", ne.Save).
+		AddButton("Cancel", ne.Cancel)
+	ne.form.SetBorder(true).
+		SetTitleAlign(tview.AlignCenter)
+	ne.form.SetCancelFunc(ne.Cancel)
+	ne.text = ne.form.GetFormItemByLabel(noteLabel).(*tview.TextArea)
+
+	return ne
+}
+
+// Edit fills the editor with a note to edit and the callbacks to run when it is closed
+func (ne *NoteEditor) Edit(title, note string, onSave func(note string), onCancel func()) {
+	ne.form.SetTitle(" " + title + " (Esc: cancel) ")
+	ne.text.SetText(note, true)
+	ne.form.SetFocus(0)
+	ne.onSave = onSave
+	ne.onCancel = onCancel
+}
+
+// Text returns the note as currently typed
+func (ne *NoteEditor) Text() string {
+	return ne.text.GetText()
+}
+
+// SetText replaces the typed note
+func (ne *NoteEditor) SetText(note string) {
+	ne.text.SetText(note, true)
+}
+
+// Save closes the editor, keeping the typed note
+func (ne *NoteEditor) Save() {
+	if ne.onSave != nil {
+		ne.onSave(ne.Text())
+	}
+}
+
+// Cancel closes the editor, discarding the typed note
+func (ne *NoteEditor) Cancel() {
+	if ne.onCancel != nil {
+		ne.onCancel()
+	}
+}
+
+// GetPrimitive returns the editor form
+func (ne *NoteEditor) GetPrimitive() tview.Primitive {
+	return ne.form
+}
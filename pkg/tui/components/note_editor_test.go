@@ -0,0 +1,35 @@
+package components
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNoteEditor(t *testing.T) {
+	editor := NewNoteEditor()
+	assert.NotNil(t, editor.GetPrimitive())
+
+	var saved []string
+	cancelled := 0
+	editor.Edit("Note on Alpha", "First draft", func(note string) {
+		saved = append(saved, note)
+	}, func() {
+		cancelled++
+	})
+	assert.Equal(t, "First draft", editor.Text())
+
+	editor.SetText("Great demo\nWeak abstract")
+	editor.Save()
+	assert.Equal(t, []string{"Great demo\nWeak abstract"}, saved)
+
+	editor.Cancel()
+	assert.Equal(t, 1, cancelled)
+	assert.Len(t, saved, 1, "cancel discards the note")
+
+	// Editing again starts from the given note
+	editor.Edit("Note on Beta", "", nil, nil)
+	assert.Empty(t, editor.Text())
+	editor.Save()
+	editor.Cancel()
+}
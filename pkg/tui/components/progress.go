@@ -5,6 +5,7 @@ package components
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -30,6 +31,7 @@ type Progress struct {
 	history        *elo.ComparisonHistory
 	config         elo.OptimizationConfig
 	currentMetrics *elo.ProgressMetrics
+	convergence    *elo.ConvergenceStatus // Stopping recommendation from elo.CheckConvergence
 
 	// Display configuration
 	showBars       bool
@@ -70,7 +72,6 @@ func NewProgress(engine *elo.Engine, config ProgressConfig) *Progress {
 		convergenceBar: tview.NewTextView(),
 		metricsText:    tview.NewTextView(),
 		statusText:     tview.NewTextView(),
-		lastUpdate:     time.Now(),
 		engine:         engine,
 		config:         elo.DefaultOptimizationConfig(),
 		showBars:       config.ShowBars,
@@ -192,8 +193,9 @@ func (p *Progress) Update(proposals []data.Proposal, history *elo.ComparisonHist
 		}
 	}
 
-	// Get current progress metrics from engine
+	// Get current progress metrics and the stopping recommendation from engine
 	p.currentMetrics = p.engine.GetProgressMetrics(ratings, history, p.config)
+	p.convergence = p.engine.CheckConvergence(ratings, history, p.config)
 	p.history = history
 
 	// Update progress bars
@@ -318,6 +320,11 @@ func (p *Progress) updateStatusDisplay() {
 	convergenceStatus := p.getConvergenceStatusText()
 	builder.WriteString(fmt.Sprintf("\nStatus: %s\n", convergenceStatus))
 
+	// Stopping criteria behind the status
+	if p.convergence != nil && len(p.convergence.CriteriaMet) > 0 {
+		builder.WriteString(p.getCriteriaText())
+	}
+
 	// Recommendations
 	recommendations := p.getRecommendations()
 	if recommendations != "" {
@@ -341,8 +348,12 @@ func (p *Progress) calculateAverageComparisonDuration() time.Duration {
 	return totalDuration / time.Duration(len(p.history.Comparisons))
 }
 
-// isConverged determines if the ranking has sufficiently converged
+// isConverged determines if the ranking has sufficiently converged,
+// following the engine's stopping recommendation when one is available
 func (p *Progress) isConverged() bool {
+	if p.convergence != nil {
+		return p.convergence.ShouldStop
+	}
 	if p.currentMetrics == nil {
 		return false
 	}
@@ -355,6 +366,26 @@ func (p *Progress) isConverged() bool {
 	return coverageThreshold && convergenceThreshold && stabilityThreshold
 }
 
+// getCriteriaText lists the stopping criteria of elo.CheckConvergence in a stable order
+func (p *Progress) getCriteriaText() string {
+	names := make([]string, 0, len(p.convergence.CriteriaMet))
+	for name := range p.convergence.CriteriaMet {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("Criteria met: [yellow]%.0f%%[white]\n", p.convergence.Confidence*100))
+	for _, name := range names {
+		mark := "[red]✗[white]"
+		if p.convergence.CriteriaMet[name] {
+			mark = "[green]✓[white]"
+		}
+		builder.WriteString(fmt.Sprintf("  %s %s\n", mark, strings.ReplaceAll(name, "_", " ")))
+	}
+	return builder.String()
+}
+
 // calculateAverageConfidence calculates overall confidence in rankings
 func (p *Progress) calculateAverageConfidence() float64 {
 	if p.currentMetrics == nil || len(p.currentMetrics.ConfidenceScores) == 0 {
@@ -441,6 +472,11 @@ func (p *Progress) GetMetrics() *elo.ProgressMetrics {
 	return p.currentMetrics
 }
 
+// GetConvergence returns the current stopping recommendation
+func (p *Progress) GetConvergence() *elo.ConvergenceStatus {
+	return p.convergence
+}
+
 // truncateID truncates a proposal ID to fit in display
 func truncateID(id string, maxLen int) string {
 	if len(id) <= maxLen {
@@ -2,11 +2,13 @@ package components
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/pashagolub/confelo/pkg/data"
 	"github.com/pashagolub/confelo/pkg/elo"
@@ -459,3 +461,41 @@ func BenchmarkCreateProgressBar(b *testing.B) {
 		progress.createProgressBar(0.5, false)
 	}
 }
+
+func TestProgressConvergenceFromEngine(t *testing.T) {
+	engine := &elo.Engine{InitialRating: 1500.0, KFactor: 32, MinRating: 0.0, MaxRating: 3000.0}
+	progress := NewProgress(engine, DefaultProgressConfig())
+
+	proposals := []data.Proposal{
+		{ID: "prop1", Title: "Test 1", Score: 1600.0},
+		{ID: "prop2", Title: "Test 2", Score: 1400.0},
+	}
+	history := elo.NewComparisonHistory()
+	history.AddComparison(elo.ComparisonResult{
+		Updates: []elo.RatingUpdate{
+			{ProposalID: "prop1", OldRating: 1500.0, NewRating: 1516.0, Delta: 16.0},
+			{ProposalID: "prop2", OldRating: 1500.0, NewRating: 1484.0, Delta: -16.0},
+		},
+		Method:    elo.Pairwise,
+		Timestamp: time.Now(),
+		Duration:  time.Second,
+	})
+
+	// The first update is never throttled
+	progress.Update(proposals, history)
+	require.NotNil(t, progress.GetMetrics())
+	require.NotNil(t, progress.GetConvergence())
+
+	// The engine's recommendation overrides the display heuristics
+	assert.Equal(t, progress.GetConvergence().ShouldStop, progress.isConverged())
+	progress.convergence = &elo.ConvergenceStatus{ShouldStop: true, Confidence: 0.75, CriteriaMet: map[string]bool{
+		"rating_stability": true, "minimum_coverage": false,
+	}}
+	progress.currentMetrics.CoverageComplete = 0
+	assert.True(t, progress.isConverged())
+	assert.Equal(t, "Rankings have converged. Consider exporting results.", progress.getRecommendations())
+
+	criteria := progress.getCriteriaText()
+	assert.Contains(t, criteria, "75%")
+	assert.Less(t, strings.Index(criteria, "minimum coverage"), strings.Index(criteria, "rating stability"))
+}
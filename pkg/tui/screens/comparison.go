@@ -5,7 +5,6 @@ package screens
 
 import (
 	"fmt"
-	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -15,6 +14,7 @@ import (
 
 	"github.com/pashagolub/confelo/pkg/data"
 	"github.com/pashagolub/confelo/pkg/elo"
+	"github.com/pashagolub/confelo/pkg/tui/components"
 )
 
 // ComparisonScreen implements the comparison interface for ranking proposals
@@ -24,7 +24,8 @@ type ComparisonScreen struct {
 	leftPanel      *tview.Flex
 	rightPanel     *tview.Flex
 	proposalsPanel *tview.Flex
-	proposalCards  []*tview.TextView // Dynamic array for 2-4 proposals
+	proposalCards  []*tview.TextView // Dynamic array for 2-8 proposals
+	cardGrid       *tview.Grid       // Scrollable grid holding the cards of groups larger than a quartet
 	controlPanel   *tview.TextView
 	progressBar    *tview.TextView
 	statusBar      *tview.TextView
@@ -33,10 +34,29 @@ type ComparisonScreen struct {
 	currentProposals []data.Proposal
 	comparisonMethod data.ComparisonMethod
 	selectedWinner   string
-	rankings         []string       // Final ranking order (1st, 2nd, 3rd, 4th)
-	proposalRanks    map[string]int // Maps proposal ID to assigned rank (1-4)
+	rankings         []string       // Final ranking order (1st, 2nd, 3rd, ...)
+	proposalRanks    map[string]int // Maps proposal ID to assigned rank (or pick order for pick-best)
 	isRanking        bool
-	currentRank      int // Next rank to assign (1-4)
+	currentRank      int // Next rank to assign
+	pickCount        int // Proposals to pick or rank in pick-best and rank-top comparisons
+
+	// Rating engine built from the session's EloConfig, shared by all comparison methods
+	engine elo.RatingEngine
+
+	// Matchup selection strategy (information gain when nil)
+	matchupStrategy elo.MatchupStrategy
+
+	// Progress panel with coverage, ETA and stopping criteria, toggled with 'm'
+	progressPanel     *components.Progress
+	showProgressPanel bool
+
+	// Reviewer notes and labels: 'a' and a number annotate a proposal, 'l' and a number label it,
+	// 'w' explains the last comparison
+	noteEditor    *components.NoteEditor
+	editingNote   bool              // Whether the note editor is open (it receives all keys)
+	labelInput    *tview.InputField // Comma-separated labels of a proposal
+	editingLabels bool              // Whether the label input is open (it receives all keys)
+	pickProposal  func(index int)   // Action on the proposal whose number is pressed next ('a' or 'l')
 
 	// App reference - we'll use any and cast as needed
 	app any
@@ -49,10 +69,12 @@ func NewComparisonScreen() *ComparisonScreen {
 		leftPanel:        tview.NewFlex(),
 		rightPanel:       tview.NewFlex(),
 		proposalsPanel:   tview.NewFlex(),
-		proposalCards:    make([]*tview.TextView, 0, 4), // Start empty, max 4
+		proposalCards:    make([]*tview.TextView, 0, elo.MaxGroupSize), // Start empty
 		controlPanel:     tview.NewTextView(),
 		progressBar:      tview.NewTextView(),
 		statusBar:        tview.NewTextView(),
+		noteEditor:       components.NewNoteEditor(),
+		labelInput:       tview.NewInputField(),
 		comparisonMethod: data.MethodPairwise,
 	}
 
@@ -81,7 +103,7 @@ func (cs *ComparisonScreen) setupUI() {
 	// Carousel is configured through its own methods
 
 	// Proposal cards will be created dynamically based on comparison method
-	// This allows support for pairwise (2), trio (3), or quartet (4) comparisons
+	// This allows support for pairwise (2), trio (3), quartet (4) or group (up to 8) comparisons
 
 	// Configure control panel - use TextView methods correctly
 	cs.controlPanel.
@@ -102,6 +124,13 @@ func (cs *ComparisonScreen) setupUI() {
 		SetTitle("Status")
 	cs.statusBar.SetDynamicColors(true)
 
+	// Configure label input: Enter saves, Esc cancels, Tab completes labels used before
+	cs.labelInput.SetLabel("Labels: ").
+		SetPlaceholder("comma-separated, e.g. beginner-friendly, needs co-speaker").
+		SetFieldBackgroundColor(tcell.ColorDarkBlue).
+		SetAutocompleteFunc(cs.completeLabel)
+	cs.labelInput.SetBorder(true)
+
 	// Proposals panel will be populated dynamically when comparison starts
 
 	// Layout left panel: proposals panel takes all space
@@ -125,10 +154,19 @@ func (cs *ComparisonScreen) setupUI() {
 	cs.updateInstructions()
 }
 
-// setupProposalCards creates the required number of proposal cards based on comparison method
+// Layout of the cards of groups larger than a quartet
+const (
+	gridColumns       = 2  // Cards side by side in each grid row
+	gridCardMinHeight = 10 // Rows below which the grid scrolls instead of shrinking cards
+	gridCardMinWidth  = 30 // Columns below which the grid scrolls instead of shrinking cards
+)
+
+// setupProposalCards creates the required number of proposal cards based on comparison method.
+// Up to four cards sit side by side; larger groups are laid out in a scrollable grid.
 func (cs *ComparisonScreen) setupProposalCards(count int) {
 	// Clear existing proposal cards from the layout
 	cs.proposalsPanel.Clear()
+	cs.cardGrid = nil
 
 	// Create new proposal cards
 	cs.proposalCards = make([]*tview.TextView, count)
@@ -141,8 +179,34 @@ func (cs *ComparisonScreen) setupProposalCards(count int) {
 		card.SetDynamicColors(true)
 
 		cs.proposalCards[i] = card
-		cs.proposalsPanel.AddItem(card, 0, 1, false)
 	}
+
+	if count <= 4 {
+		for _, card := range cs.proposalCards {
+			cs.proposalsPanel.AddItem(card, 0, 1, false)
+		}
+		return
+	}
+
+	rows := (count + gridColumns - 1) / gridColumns
+	cs.cardGrid = tview.NewGrid().
+		SetRows(make([]int, rows)...).
+		SetColumns(make([]int, gridColumns)...).
+		SetMinSize(gridCardMinHeight, gridCardMinWidth)
+	for i, card := range cs.proposalCards {
+		cs.cardGrid.AddItem(card, i/gridColumns, i%gridColumns, 1, 1, 0, 0, false)
+	}
+	cs.proposalsPanel.AddItem(cs.cardGrid, 0, 1, false)
+}
+
+// scrollCards moves the card grid by the given number of rows
+func (cs *ComparisonScreen) scrollCards(rows int) {
+	if cs.cardGrid == nil {
+		return
+	}
+	offset, _ := cs.cardGrid.GetOffset()
+	last := (len(cs.proposalCards)+gridColumns-1)/gridColumns - 1
+	cs.cardGrid.SetOffset(max(0, min(offset+rows, last)), 0)
 }
 
 // updateProposalDisplay updates the display of all proposal cards
@@ -189,6 +253,14 @@ func (cs *ComparisonScreen) formatProposalContent(proposal data.Proposal) string
 	// Current rating
 	content.WriteString(fmt.Sprintf("[blue]Current Rating:[-] %.0f", proposal.Score))
 
+	// Reviewer labels and note (if any)
+	if len(proposal.Labels) > 0 {
+		content.WriteString(fmt.Sprintf("\n\n[fuchsia]Labels:[-] %s", tview.Escape(strings.Join(proposal.Labels, ", "))))
+	}
+	if proposal.Comment != "" {
+		content.WriteString(fmt.Sprintf("\n\n[cyan]Note:[-] %s", tview.Escape(proposal.Comment)))
+	}
+
 	return content.String()
 }
 
@@ -212,12 +284,23 @@ func (cs *ComparisonScreen) OnEnter(app any) error {
 				cs.comparisonMethod = data.MethodTrio
 			case "quartet":
 				cs.comparisonMethod = data.MethodQuartet
+			case "pick-best":
+				cs.comparisonMethod = data.MethodPickBest
+			case "rank-top":
+				cs.comparisonMethod = data.MethodRankTop
 			default:
 				cs.comparisonMethod = data.MethodPairwise // fallback
 			}
 		}
 	}
 
+	// Build the rating engine once from the session configuration
+	engine, err := cs.buildEngine()
+	if err != nil {
+		return fmt.Errorf("failed to create rating engine: %w", err)
+	}
+	cs.engine = engine
+
 	// Load proposals for comparison
 	if err := cs.loadNextComparison(); err != nil {
 		return fmt.Errorf("failed to load comparison: %w", err)
@@ -227,6 +310,47 @@ func (cs *ComparisonScreen) OnEnter(app any) error {
 	return nil
 }
 
+// buildEngine creates the rating engine from the session's Elo configuration
+func (cs *ComparisonScreen) buildEngine() (elo.RatingEngine, error) {
+	return newSessionEngine(cs.getSession())
+}
+
+// sessionEloConfig returns the session's Elo settings,
+// falling back to the defaults when no session or Elo settings are available
+func sessionEloConfig(session *data.Session) data.EloConfig {
+	if session != nil && session.Config.Elo.KFactor > 0 {
+		return session.Config.Elo
+	}
+	return data.DefaultEloConfig()
+}
+
+// newSessionEngine creates the rating engine of the session's rating system (Elo or Glicko-2)
+func newSessionEngine(session *data.Session) (elo.RatingEngine, error) {
+	eloConfig := sessionEloConfig(session)
+
+	return elo.NewRatingEngine(elo.RatingSystem(eloConfig.RatingSystem), eloConfig.EngineConfig())
+}
+
+// newSessionEloEngine creates a classic Elo engine from the session's Elo settings;
+// matchup selection relies on Elo win probabilities whatever the rating system
+func newSessionEloEngine(session *data.Session) (*elo.Engine, error) {
+	eloConfig := sessionEloConfig(session)
+
+	return elo.NewEngine(eloConfig.EngineConfig())
+}
+
+// getEngine returns the shared rating engine, building it on first use
+func (cs *ComparisonScreen) getEngine() (elo.RatingEngine, error) {
+	if cs.engine == nil {
+		engine, err := cs.buildEngine()
+		if err != nil {
+			return nil, err
+		}
+		cs.engine = engine
+	}
+	return cs.engine, nil
+}
+
 // OnExit is called when leaving the screen
 func (cs *ComparisonScreen) OnExit(app any) error {
 	// Save any pending comparison state if needed
@@ -240,10 +364,30 @@ func (cs *ComparisonScreen) GetTitle() string {
 
 // handleInput processes keyboard input for the comparison screen
 func (cs *ComparisonScreen) handleInput(event *tcell.EventKey) *tcell.EventKey {
+	// Keys go to the note editor or label input while it is open
+	if cs.editingNote || cs.editingLabels {
+		return event
+	}
+	if pick := cs.pickProposal; pick != nil {
+		cs.pickProposal = nil
+		if index, err := strconv.Atoi(string(event.Rune())); err == nil && index >= 1 && index <= len(cs.currentProposals) {
+			pick(index - 1)
+		} else {
+			cs.updateStatus()
+		}
+		return nil
+	}
+
 	switch event.Key() {
 	case tcell.KeyUp, tcell.KeyDown:
 		// Allow scrolling within proposal content
 		return event
+	case tcell.KeyPgDn:
+		cs.scrollCards(1)
+		return nil
+	case tcell.KeyPgUp:
+		cs.scrollCards(-1)
+		return nil
 	}
 
 	switch event.Rune() {
@@ -251,9 +395,9 @@ func (cs *ComparisonScreen) handleInput(event *tcell.EventKey) *tcell.EventKey {
 		return tcell.NewEventKey(tcell.KeyDown, 0, tcell.ModNone)
 	case 'k':
 		return tcell.NewEventKey(tcell.KeyUp, 0, tcell.ModNone)
-	case '1', '2', '3', '4':
-		// For trio/quartet mode, automatically use ranking
-		if cs.comparisonMethod == data.MethodTrio || cs.comparisonMethod == data.MethodQuartet {
+	case '1', '2', '3', '4', '5', '6', '7', '8':
+		// For trio/quartet and group modes, automatically use ranking
+		if cs.comparisonMethod != data.MethodPairwise {
 			if !cs.isRanking {
 				cs.startRanking()
 			}
@@ -266,9 +410,26 @@ func (cs *ComparisonScreen) handleInput(event *tcell.EventKey) *tcell.EventKey {
 		}
 		cs.selectWinner(string(event.Rune()))
 		return nil
+	case '=':
+		if !cs.isRanking {
+			cs.declareDraw()
+		}
+		return nil
 	case 's', 'n':
 		cs.nextComparison()
 		return nil
+	case 'u':
+		// Inside ranking mode 'u' undoes the last rank assignment instead
+		if cs.handleRankingInput(event.Rune()) {
+			return nil
+		}
+		cs.undoComparison()
+		return nil
+	case 'y':
+		if !cs.isRanking {
+			cs.redoComparison()
+		}
+		return nil
 	case 'p':
 		cs.setComparisonMode(data.MethodPairwise)
 		return nil
@@ -278,6 +439,30 @@ func (cs *ComparisonScreen) handleInput(event *tcell.EventKey) *tcell.EventKey {
 	case 'q':
 		cs.setComparisonMode(data.MethodQuartet)
 		return nil
+	case 'b':
+		cs.setComparisonMode(data.MethodPickBest)
+		return nil
+	case 'o':
+		cs.setComparisonMode(data.MethodRankTop)
+		return nil
+	case 'm':
+		cs.toggleProgressPanel()
+		return nil
+	case 'a':
+		if len(cs.currentProposals) > 0 {
+			cs.pickProposal = cs.editProposalNote
+			cs.statusBar.SetText("[yellow]Press the number of the proposal to annotate[-]")
+		}
+		return nil
+	case 'l':
+		if len(cs.currentProposals) > 0 {
+			cs.pickProposal = cs.editProposalLabels
+			cs.statusBar.SetText("[yellow]Press the number of the proposal to label[-]")
+		}
+		return nil
+	case 'w':
+		cs.editComparisonNote()
+		return nil
 	case '\r', '\n': // Enter key
 		if cs.handleRankingInput(event.Rune()) {
 			return nil
@@ -301,23 +486,23 @@ func (cs *ComparisonScreen) loadNextComparison() error {
 	// Check convergence criteria before loading next comparison
 	config := cs.getConfig()
 	if config != nil && config.Convergence.EnableEarlyStopping {
-		completed := session.TotalComparisons
-
-		// Check if we've reached minimum comparisons and convergence is achieved
-		if completed >= config.Convergence.MinComparisons {
-			if cs.checkTopTConvergence() {
-				return fmt.Errorf("ranking has converged - top %d proposals are stable", config.Convergence.TargetAccepted)
-			}
-		}
-
 		// Hard limit check
-		if completed >= config.Convergence.MaxComparisons {
+		if config.Convergence.MaxComparisons > 0 && session.TotalComparisons >= config.Convergence.MaxComparisons {
 			return fmt.Errorf("maximum comparison limit reached (%d)", config.Convergence.MaxComparisons)
 		}
+
+		// The evaluation only recommends stopping after the minimum number of comparisons
+		if cs.checkConvergence() {
+			return fmt.Errorf("ranking has converged - stopping criteria are met")
+		}
 	}
 
-	proposals := session.Proposals
+	// Proposals conflicting with the reviewer are never presented
+	proposals := session.GetReviewableProposals()
 	if len(proposals) < 2 {
+		if excluded := len(session.Proposals) - len(proposals); excluded > 0 {
+			return fmt.Errorf("not enough proposals for comparison (%d excluded by your conflicts)", excluded)
+		}
 		return fmt.Errorf("not enough proposals for comparison")
 	}
 
@@ -328,15 +513,25 @@ func (cs *ComparisonScreen) loadNextComparison() error {
 		count = 3
 	case data.MethodQuartet:
 		count = 4
+	case data.MethodPickBest, data.MethodRankTop:
+		uiConfig := data.DefaultUIConfig()
+		if config != nil {
+			uiConfig = config.UI
+		}
+		count, cs.pickCount = uiConfig.PartialGroup()
 	}
 
 	if len(proposals) < count {
 		count = len(proposals)
-		cs.comparisonMethod = data.MethodPairwise
+		if cs.comparisonMethod.IsPartial() && count >= 3 {
+			cs.pickCount = min(cs.pickCount, count-1) // Smaller group, still leave some unpicked
+		} else {
+			cs.comparisonMethod = data.MethodPairwise
+		}
 	}
 
-	// Find next uncompared pair/group of proposals
-	nextProposals := cs.findNextComparison(proposals, count, session.CompletedComparisons)
+	// Find next most informative pair/group of proposals
+	nextProposals := cs.findNextComparison(proposals, count, session)
 	if nextProposals == nil {
 		return fmt.Errorf("no more comparisons available")
 	}
@@ -350,61 +545,57 @@ func (cs *ComparisonScreen) loadNextComparison() error {
 	return nil
 }
 
-// findNextComparison finds the next set of proposals that haven't been compared yet
-func (cs *ComparisonScreen) findNextComparison(proposals []data.Proposal, count int, completed []data.Comparison) []data.Proposal {
-	// Create a set of completed comparisons for quick lookup
-	completedSet := make(map[string]bool)
-	for _, comp := range completed {
-		// Create a sorted key from proposal IDs
-		key := cs.createComparisonKey(comp.ProposalIDs)
-		completedSet[key] = true
-	}
-
-	// For pairwise comparisons, find next uncompleted pair
-	if count == 2 {
-		for i := 0; i < len(proposals); i++ {
-			for j := i + 1; j < len(proposals); j++ {
-				proposalIDs := []string{proposals[i].ID, proposals[j].ID}
-				key := cs.createComparisonKey(proposalIDs)
+// SetMatchupStrategy replaces the strategy used to select the next comparison (nil restores the default)
+func (cs *ComparisonScreen) SetMatchupStrategy(strategy elo.MatchupStrategy) {
+	cs.matchupStrategy = strategy
+}
 
-				if !completedSet[key] {
-					return []data.Proposal{proposals[i], proposals[j]}
-				}
-			}
+// getMatchupStrategy returns the configured strategy or the information-gain default,
+// kept within groups when the session groups proposals (e.g. by track)
+func (cs *ComparisonScreen) getMatchupStrategy() (elo.MatchupStrategy, error) {
+	strategy := cs.matchupStrategy
+	if strategy == nil {
+		engine, err := newSessionEloEngine(cs.getSession())
+		if err != nil {
+			return nil, err
 		}
+		strategy = elo.NewInformationGainStrategy(engine, elo.DefaultOptimizationConfig())
 	}
 
-	// For multi-way comparisons (trio, quartet), implement similar logic
-	// For now, just use a simple round-robin approach
-	if count > 2 {
-		// Simple implementation: take next sequential group
-		startIdx := len(completed) % (len(proposals) - count + 1)
-		if startIdx+count <= len(proposals) {
-			result := make([]data.Proposal, count)
-			copy(result, proposals[startIdx:startIdx+count])
-			return result
-		}
+	if session := cs.getSession(); session != nil && session.Config.Groups.Enabled() {
+		return elo.NewGroupedStrategy(strategy, session.GetProposalGroups(), session.Config.Groups.CrossGroupRate), nil
 	}
-
-	return nil // No more comparisons available
+	return strategy, nil
 }
 
-// createComparisonKey creates a consistent key for comparison lookup
-func (cs *ComparisonScreen) createComparisonKey(proposalIDs []string) string {
-	// Sort IDs to ensure consistent key regardless of order
-	sortedIDs := make([]string, len(proposalIDs))
-	copy(sortedIDs, proposalIDs)
-
-	// Simple sort for small arrays
-	for i := 0; i < len(sortedIDs); i++ {
-		for j := i + 1; j < len(sortedIDs); j++ {
-			if sortedIDs[i] > sortedIDs[j] {
-				sortedIDs[i], sortedIDs[j] = sortedIDs[j], sortedIDs[i]
-			}
+// findNextComparison selects the next set of proposals using the matchup strategy
+func (cs *ComparisonScreen) findNextComparison(proposals []data.Proposal, count int, session *data.Session) []data.Proposal {
+	ratings := make([]elo.Rating, len(proposals))
+	proposalMap := make(map[string]data.Proposal, len(proposals))
+	for i, proposal := range proposals {
+		ratings[i] = elo.Rating{
+			ID:    proposal.ID,
+			Score: proposal.Score,
+			Games: cs.getProposalComparisonCount(proposal.ID, session),
 		}
+		proposalMap[proposal.ID] = proposal
 	}
 
-	return strings.Join(sortedIDs, ",")
+	strategy, err := cs.getMatchupStrategy()
+	if err != nil {
+		return nil
+	}
+
+	ids := strategy.NextGroup(ratings, data.ComparisonHistoryOf(session.CompletedComparisons), count)
+	if len(ids) != count {
+		return nil // No more comparisons available
+	}
+
+	result := make([]data.Proposal, count)
+	for i, id := range ids {
+		result[i] = proposalMap[id]
+	}
+	return result
 }
 
 // updateDisplay refreshes the UI state (carousel handles its own display)
@@ -431,6 +622,17 @@ func (cs *ComparisonScreen) selectWinner(winner string) {
 	cs.nextComparison()
 }
 
+// declareDraw records the current pairwise comparison as equally good
+func (cs *ComparisonScreen) declareDraw() {
+	if cs.comparisonMethod != data.MethodPairwise || len(cs.currentProposals) != 2 {
+		return // Draws are only offered for pairwise comparisons
+	}
+
+	_ = cs.executeDraw() // Skip to next comparison if there's an error
+
+	cs.nextComparison()
+}
+
 // startRanking initiates multi-way ranking mode
 func (cs *ComparisonScreen) startRanking() {
 	if len(cs.currentProposals) < 2 {
@@ -453,15 +655,26 @@ func (cs *ComparisonScreen) handleRankingInput(key rune) bool {
 	}
 
 	switch key {
-	case '1', '2', '3', '4':
-		// Numbers assign the current rank to a specific proposal
+	case '1', '2', '3', '4', '5', '6', '7', '8':
+		// Numbers assign the current rank to a specific proposal (or toggle a pick)
 		proposalIndex, _ := strconv.Atoi(string(key))
-		if proposalIndex >= 1 && proposalIndex <= len(cs.currentProposals) {
+		if proposalIndex < 1 || proposalIndex > len(cs.currentProposals) {
+			return true
+		}
+		switch {
+		case cs.comparisonMethod == data.MethodPickBest:
+			cs.togglePick(proposalIndex - 1)
+		case cs.currentRank > cs.selectionTarget():
+			// Everything to rank is ranked; only already ranked proposals can move
+			if _, ranked := cs.proposalRanks[cs.currentProposals[proposalIndex-1].ID]; ranked {
+				cs.assignRankToProposal(proposalIndex-1, cs.currentRank-1)
+			}
+		default:
 			cs.assignRankToProposal(proposalIndex-1, cs.currentRank)
 		}
 		return true
 	case '\r', '\n': // Enter key to confirm ranking (only if all ranks assigned)
-		if len(cs.proposalRanks) == len(cs.currentProposals) {
+		if len(cs.proposalRanks) == cs.selectionTarget() {
 			cs.confirmRanking()
 		}
 		return true
@@ -476,9 +689,38 @@ func (cs *ComparisonScreen) handleRankingInput(key rune) bool {
 	return false
 }
 
+// selectionTarget returns how many proposals must be ranked or picked before confirming:
+// all of them, or the pick count in pick-best and rank-top comparisons
+func (cs *ComparisonScreen) selectionTarget() int {
+	if cs.comparisonMethod.IsPartial() && cs.pickCount > 0 && cs.pickCount < len(cs.currentProposals) {
+		return cs.pickCount
+	}
+	return len(cs.currentProposals)
+}
+
+// togglePick picks or unpicks a proposal in a pick-best comparison
+func (cs *ComparisonScreen) togglePick(proposalIndex int) {
+	proposalID := cs.currentProposals[proposalIndex].ID
+	if order, picked := cs.proposalRanks[proposalID]; picked {
+		delete(cs.proposalRanks, proposalID)
+		for id, other := range cs.proposalRanks {
+			if other > order {
+				cs.proposalRanks[id] = other - 1
+			}
+		}
+		cs.currentRank--
+	} else if len(cs.proposalRanks) < cs.selectionTarget() {
+		cs.proposalRanks[proposalID] = cs.currentRank
+		cs.currentRank++
+	}
+
+	cs.buildRankingsArray()
+	cs.updateDisplay()
+}
+
 // confirmRanking finalizes the ranking and processes the multi-way comparison
 func (cs *ComparisonScreen) confirmRanking() {
-	if !cs.isRanking || len(cs.proposalRanks) != len(cs.currentProposals) {
+	if !cs.isRanking || len(cs.proposalRanks) != cs.selectionTarget() {
 		return
 	}
 
@@ -562,7 +804,8 @@ func (cs *ComparisonScreen) undoLastRank() {
 	}
 }
 
-// buildRankingsArray constructs the rankings array from proposalRanks map
+// buildRankingsArray constructs the rankings array from proposalRanks map.
+// In pick-best and rank-top comparisons the proposals left out follow in presented order.
 func (cs *ComparisonScreen) buildRankingsArray() {
 	cs.rankings = make([]string, len(cs.currentProposals))
 
@@ -572,6 +815,16 @@ func (cs *ComparisonScreen) buildRankingsArray() {
 			cs.rankings[rank-1] = proposalID
 		}
 	}
+
+	if cs.comparisonMethod.IsPartial() {
+		next := len(cs.proposalRanks)
+		for _, proposal := range cs.currentProposals {
+			if _, ranked := cs.proposalRanks[proposal.ID]; !ranked && next < len(cs.rankings) {
+				cs.rankings[next] = proposal.ID
+				next++
+			}
+		}
+	}
 }
 
 // executeMultiWayComparison processes a multi-way ranking result
@@ -581,41 +834,11 @@ func (cs *ComparisonScreen) executeMultiWayComparison() error {
 		return fmt.Errorf("no active session")
 	}
 
-	// For now, implement a simple approach:
-	// Award points based on ranking position and update ratings accordingly
-	rankPoints := make(map[string]float64)
-	totalProposals := len(cs.rankings)
-
-	for i, proposalID := range cs.rankings {
-		// Higher rank (lower index) gets more points
-		points := float64(totalProposals - i)
-		rankPoints[proposalID] = points
-	}
-
-	// Update ratings based on relative performance
-	avgPoints := float64(totalProposals+1) / 2.0
-	for proposalID, points := range rankPoints {
-		ratingChange := (points - avgPoints) * 8.0 // Scale factor for rating change
-
-		// Find and update the proposal in session
-		for i := range session.Proposals {
-			if session.Proposals[i].ID == proposalID {
-				session.Proposals[i].Score += ratingChange
-
-				// Ensure rating stays within bounds
-				if session.Proposals[i].Score < 0 {
-					session.Proposals[i].Score = 0
-				} else if session.Proposals[i].Score > 3000 {
-					session.Proposals[i].Score = 3000
-				}
-
-				session.Proposals[i].UpdatedAt = time.Now()
-				break
-			}
-		}
+	engine, err := cs.getEngine()
+	if err != nil {
+		return err
 	}
 
-	// Record the comparison
 	comparison := data.Comparison{
 		ID:          cs.generateComparisonID(),
 		SessionName: session.Name,
@@ -625,27 +848,69 @@ func (cs *ComparisonScreen) executeMultiWayComparison() error {
 		Method:      cs.comparisonMethod,
 		Timestamp:   time.Now(),
 	}
+	if cs.comparisonMethod.IsPartial() {
+		comparison.Selected = cs.selectionTarget()
+	}
 
-	session.CompletedComparisons = append(session.CompletedComparisons, comparison)
-
-	// Update lightweight comparison tracking for progress and confidence
-	session.TotalComparisons++
-	for _, proposalID := range cs.getProposalIDs() {
-		session.ComparisonCounts[proposalID]++
+	// Partial comparisons leave the proposals sharing a position unordered
+	comparison.EloUpdates, err = cs.multiWayUpdates(session, engine, comparison.ID, comparison.OutcomePositions())
+	if err != nil {
+		return err
 	}
 
-	// Update convergence metrics to reflect new comparison
-	if session.ConvergenceMetrics != nil {
-		session.ConvergenceMetrics.TotalComparisons = session.TotalComparisons
-		session.ConvergenceMetrics.LastCalculated = time.Now()
+	// Record the comparison (session applies the rating updates)
+	return cs.recordComparison(session, comparison)
+}
+
+// multiWayUpdates rates the ranking as one multi-way comparison of the rating engine, the same
+// calculation elo.Engine.Replay and the simulation use. Classic Elo goes through
+// elo.MultiWayComparison so the position-weighted games are checked for rating conservation.
+// Positions of a partial order rate it with the engine's partial comparison (nil ranks every proposal).
+func (cs *ComparisonScreen) multiWayUpdates(session *data.Session, engine elo.RatingEngine, comparisonID string, positions []int) ([]data.EloUpdate, error) {
+	ranked := make([]elo.Rating, 0, len(cs.rankings))
+	for _, proposalID := range cs.rankings {
+		proposal, err := session.GetProposalByID(proposalID)
+		if err != nil {
+			return nil, err
+		}
+		ranked = append(ranked, proposalRating(*proposal))
 	}
 
-	// Save session back to app
-	if app, ok := cs.app.(interface{ SetSession(*data.Session) }); ok {
-		app.SetSession(session)
+	var updated []elo.Rating
+	if eloEngine, ok := engine.(*elo.Engine); ok {
+		var multiWay *elo.MultiWayComparison
+		var err error
+		if positions != nil {
+			multiWay, err = eloEngine.NewPartialComparison(ranked, positions)
+		} else {
+			multiWay, err = eloEngine.NewMultiWayComparison(ranked)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if updated, _, err = multiWay.Execute(); err != nil {
+			return nil, err
+		}
+		if err := multiWay.ValidateRatingConservation(); err != nil {
+			return nil, err
+		}
+	} else {
+		var err error
+		if positions != nil {
+			updated, _, err = engine.CalculatePartial(ranked, positions)
+		} else {
+			updated, _, err = engine.CalculateMultiway(ranked)
+		}
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	return nil
+	updates := make([]data.EloUpdate, 0, len(updated))
+	for i, rating := range updated {
+		updates = append(updates, cs.newEloUpdate(comparisonID, ranked[i], rating, engineKFactor(engine)))
+	}
+	return updates, nil
 }
 
 // nextComparison loads the next comparison set
@@ -671,9 +936,9 @@ func (cs *ComparisonScreen) showCompletionMessage() {
 	var completionTitle string
 	var completionReason string
 
-	if config != nil && config.Convergence.EnableEarlyStopping && cs.checkTopTConvergence() {
+	if config != nil && config.Convergence.EnableEarlyStopping && cs.checkConvergence() {
 		completionTitle = "🎯 Ranking Converged!"
-		completionReason = fmt.Sprintf("Top-%d proposals have stabilized.\nNo need for additional comparisons!", config.Convergence.TargetAccepted)
+		completionReason = "Ratings and the top rankings have stabilized.\nNo need for additional comparisons!"
 	} else {
 		completionTitle = "🎉 All Comparisons Complete!"
 		completionReason = "All possible comparisons have been finished."
@@ -725,15 +990,14 @@ func (cs *ComparisonScreen) executeComparison() error {
 		return fmt.Errorf("no active session")
 	}
 
-	// Create a simple Elo engine for basic calculations
-	engine := &elo.Engine{
-		InitialRating: 1500.0,
-		KFactor:       32,
-		MinRating:     0.0,
-		MaxRating:     3000.0,
+	engine, err := cs.getEngine()
+	if err != nil {
+		return err
 	}
 
 	startTime := time.Now()
+	comparisonID := cs.generateComparisonID()
+	var updates []data.EloUpdate
 
 	// For pairwise comparison, just do a simple rating swap
 	if cs.comparisonMethod == data.MethodPairwise && len(cs.currentProposals) == 2 {
@@ -744,36 +1008,24 @@ func (cs *ComparisonScreen) executeComparison() error {
 			loserIdx = 0
 		}
 
-		winner := elo.Rating{
-			ID:    cs.currentProposals[winnerIdx].ID,
-			Score: cs.currentProposals[winnerIdx].Score,
-		}
-		loser := elo.Rating{
-			ID:    cs.currentProposals[loserIdx].ID,
-			Score: cs.currentProposals[loserIdx].Score,
-		}
+		winner := proposalRating(cs.currentProposals[winnerIdx])
+		loser := proposalRating(cs.currentProposals[loserIdx])
 
 		newWinner, newLoser, err := engine.CalculatePairwise(winner, loser)
 		if err != nil {
 			return err
 		}
 
-		// Update session with new ratings
-		for i := range session.Proposals {
-			switch session.Proposals[i].ID {
-			case newWinner.ID:
-				session.Proposals[i].Score = newWinner.Score
-				session.Proposals[i].UpdatedAt = time.Now()
-			case newLoser.ID:
-				session.Proposals[i].Score = newLoser.Score
-				session.Proposals[i].UpdatedAt = time.Now()
-			}
+		// Record rating changes so they can be undone later
+		updates = []data.EloUpdate{
+			cs.newEloUpdate(comparisonID, winner, newWinner, engineKFactor(engine)),
+			cs.newEloUpdate(comparisonID, loser, newLoser, engineKFactor(engine)),
 		}
 	}
 
-	// Record completed comparison
+	// Record completed comparison (session applies the rating updates)
 	comparison := data.Comparison{
-		ID:          cs.generateComparisonID(),
+		ID:          comparisonID,
 		SessionName: session.Name,
 		ProposalIDs: cs.getProposalIDs(),
 		WinnerID:    cs.selectedWinner,
@@ -781,30 +1033,316 @@ func (cs *ComparisonScreen) executeComparison() error {
 		Method:      cs.comparisonMethod,
 		Timestamp:   time.Now(),
 		Duration:    time.Since(startTime),
+		EloUpdates:  updates,
+	}
+
+	return cs.recordComparison(session, comparison)
+}
+
+// executeDraw rates both proposals of a pairwise comparison as equally good (S=0.5)
+func (cs *ComparisonScreen) executeDraw() error {
+	session := cs.getSession()
+	if session == nil {
+		return fmt.Errorf("no active session")
+	}
+
+	engine, err := cs.getEngine()
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	comparisonID := cs.generateComparisonID()
+
+	a := proposalRating(cs.currentProposals[0])
+	b := proposalRating(cs.currentProposals[1])
+	newA, newB, err := engine.CalculateDraw(a, b)
+	if err != nil {
+		return err
+	}
+
+	comparison := data.Comparison{
+		ID:          comparisonID,
+		SessionName: session.Name,
+		ProposalIDs: cs.getProposalIDs(),
+		Draw:        true,
+		Method:      data.MethodPairwise,
+		Timestamp:   time.Now(),
+		Duration:    time.Since(startTime),
+		EloUpdates: []data.EloUpdate{
+			cs.newEloUpdate(comparisonID, a, newA, engineKFactor(engine)),
+			cs.newEloUpdate(comparisonID, b, newB, engineKFactor(engine)),
+		},
+	}
+
+	return cs.recordComparison(session, comparison)
+}
+
+// recordComparison stores a completed comparison in the session and publishes the session back to the app
+func (cs *ComparisonScreen) recordComparison(session *data.Session, comparison data.Comparison) error {
+	if err := session.RecordComparison(comparison); err != nil {
+		return err
+	}
+
+	cs.publishSession(session)
+	return nil
+}
+
+// newEloUpdate builds a rating change record for a comparison
+func (cs *ComparisonScreen) newEloUpdate(comparisonID string, old, updated elo.Rating, kFactor int) data.EloUpdate {
+	return data.EloUpdate{
+		ID:            fmt.Sprintf("upd_%s_%d", old.ID, time.Now().UnixNano()),
+		ComparisonID:  comparisonID,
+		ProposalID:    old.ID,
+		OldRating:     old.Score,
+		NewRating:     updated.Score,
+		RatingDelta:   updated.Score - old.Score,
+		KFactor:       kFactor,
+		OldDeviation:  old.Deviation,
+		NewDeviation:  updated.Deviation,
+		OldVolatility: old.Volatility,
+		NewVolatility: updated.Volatility,
+	}
+}
+
+// proposalRating converts a proposal into an engine rating including its Glicko-2 uncertainty
+func proposalRating(proposal data.Proposal) elo.Rating {
+	return elo.Rating{
+		ID:         proposal.ID,
+		Score:      proposal.Score,
+		Deviation:  proposal.Deviation,
+		Volatility: proposal.Volatility,
 	}
+}
 
-	session.CompletedComparisons = append(session.CompletedComparisons, comparison)
+// engineKFactor returns the K-factor recorded on rating updates (0 for engines without one)
+func engineKFactor(engine elo.RatingEngine) int {
+	if eloEngine, ok := engine.(*elo.Engine); ok {
+		return eloEngine.KFactor
+	}
+	return 0
+}
 
-	// Update lightweight comparison tracking for progress and confidence
-	session.TotalComparisons++
-	for _, proposalID := range cs.getProposalIDs() {
-		session.ComparisonCounts[proposalID]++
+// undoComparison reverts the last completed comparison and presents its matchup again
+func (cs *ComparisonScreen) undoComparison() {
+	session := cs.getSession()
+	if session == nil {
+		return
 	}
 
-	// Update convergence metrics to reflect new comparison
+	comparison, err := session.UndoLastComparison()
+	if err != nil {
+		cs.statusBar.SetText("[yellow]Nothing to undo[-]")
+		return
+	}
+
+	cs.publishSession(session)
+	cs.presentComparison(session, comparison)
+	cs.statusBar.SetText(fmt.Sprintf("[green]Undid comparison of %d proposals[-]", len(comparison.ProposalIDs)))
+}
+
+// redoComparison re-applies the last undone comparison and moves on to the next matchup
+func (cs *ComparisonScreen) redoComparison() {
+	session := cs.getSession()
+	if session == nil {
+		return
+	}
+
+	if _, err := session.RedoComparison(); err != nil {
+		cs.statusBar.SetText("[yellow]Nothing to redo[-]")
+		return
+	}
+
+	cs.publishSession(session)
+	cs.nextComparison()
+	cs.statusBar.SetText("[green]Redid comparison[-]")
+}
+
+// publishSession keeps convergence metrics in sync and hands the session back to the app
+func (cs *ComparisonScreen) publishSession(session *data.Session) {
+	// Keep convergence metrics in line with the lightweight comparison tracking
 	if session.ConvergenceMetrics != nil {
 		session.ConvergenceMetrics.TotalComparisons = session.TotalComparisons
-		session.ConvergenceMetrics.LastCalculated = time.Now()
 	}
 
-	// Save session back to app if possible
 	if app, ok := cs.app.(interface{ SetSession(*data.Session) }); ok {
 		app.SetSession(session)
 	}
+}
+
+// presentComparison shows the proposals of a previous comparison with their current ratings
+func (cs *ComparisonScreen) presentComparison(session *data.Session, comparison *data.Comparison) {
+	proposals := make([]data.Proposal, 0, len(comparison.ProposalIDs))
+	for _, id := range comparison.ProposalIDs {
+		proposal, err := session.GetProposalByID(id)
+		if err != nil {
+			return // Proposal no longer available, keep current matchup
+		}
+		proposals = append(proposals, *proposal)
+	}
+
+	cs.comparisonMethod = comparison.Method
+	if comparison.Selected > 0 {
+		cs.pickCount = comparison.Selected
+	}
+	cs.currentProposals = proposals
+	cs.selectedWinner = ""
+	cs.rankings = nil
+	cs.proposalRanks = nil
+	cs.isRanking = false
+	cs.currentRank = 1
 
+	cs.updateProposalDisplay()
+	cs.updateDisplay()
+}
+
+// editProposalNote opens the note editor on a shown proposal
+func (cs *ComparisonScreen) editProposalNote(index int) {
+	proposal := cs.currentProposals[index]
+	cs.openNoteEditor(fmt.Sprintf("Note on %s", tview.Escape(proposal.Title)), proposal.Comment, func(note string) error {
+		session := cs.getSession()
+		if session == nil {
+			return fmt.Errorf("no active session")
+		}
+		if err := session.SetNote(proposal.ID, note); err != nil {
+			return err
+		}
+		cs.currentProposals[index].Comment = session.GetNote(proposal.ID)
+		cs.updateProposalDisplay()
+		cs.publishSession(session)
+		return nil
+	})
+}
+
+// editComparisonNote opens the note editor on the last completed comparison ("why I picked this")
+func (cs *ComparisonScreen) editComparisonNote() {
+	session := cs.getSession()
+	if session == nil {
+		return
+	}
+	history := session.GetComparisonHistory()
+	if len(history) == 0 {
+		cs.statusBar.SetText("[yellow]No comparison to explain yet[-]")
+		return
+	}
+
+	last := history[len(history)-1]
+	cs.openNoteEditor("Why (last comparison)", last.Note, func(note string) error {
+		if err := session.SetComparisonNote(last.ID, note); err != nil {
+			return err
+		}
+		cs.publishSession(session)
+		return nil
+	})
+}
+
+// openNoteEditor shows the note editor below the proposals; save stores the edited note
+func (cs *ComparisonScreen) openNoteEditor(title, note string, save func(note string) error) {
+	cs.noteEditor.Edit(title, note, func(text string) {
+		cs.closeNoteEditor()
+		if err := save(text); err != nil {
+			cs.statusBar.SetText(fmt.Sprintf("[red]Error saving note: %v[-]", tview.Escape(err.Error())))
+		}
+	}, cs.closeNoteEditor)
+
+	if !cs.editingNote {
+		cs.editingNote = true
+		cs.leftPanel.AddItem(cs.noteEditor.GetPrimitive(), 8, 0, true)
+	}
+	cs.setFocus(cs.noteEditor.GetPrimitive())
+}
+
+// closeNoteEditor hides the note editor and returns focus to the comparison
+func (cs *ComparisonScreen) closeNoteEditor() {
+	if cs.editingNote {
+		cs.editingNote = false
+		cs.leftPanel.RemoveItem(cs.noteEditor.GetPrimitive())
+	}
+	cs.updateStatus()
+	cs.setFocus(cs.container)
+}
+
+// editProposalLabels opens the label input below the proposals on a shown proposal
+func (cs *ComparisonScreen) editProposalLabels(index int) {
+	proposal := cs.currentProposals[index]
+	cs.labelInput.SetTitle(fmt.Sprintf(" Labels of %s (Enter: save, Esc: cancel) ", tview.Escape(proposal.Title)))
+	cs.labelInput.SetText(strings.Join(proposal.Labels, ", "))
+	cs.labelInput.SetDoneFunc(func(key tcell.Key) {
+		cs.closeLabelInput()
+		if key != tcell.KeyEnter {
+			return
+		}
+		if err := cs.saveLabels(index, cs.labelInput.GetText()); err != nil {
+			cs.statusBar.SetText(fmt.Sprintf("[red]Error saving labels: %v[-]", tview.Escape(err.Error())))
+		}
+	})
+
+	if !cs.editingLabels {
+		cs.editingLabels = true
+		cs.leftPanel.AddItem(cs.labelInput, 3, 0, true)
+	}
+	cs.setFocus(cs.labelInput)
+}
+
+// saveLabels stores the comma-separated labels of a shown proposal
+func (cs *ComparisonScreen) saveLabels(index int, text string) error {
+	session := cs.getSession()
+	if session == nil {
+		return fmt.Errorf("no active session")
+	}
+
+	id := cs.currentProposals[index].ID
+	if err := session.SetLabels(id, data.ParseLabels(text)); err != nil {
+		return err
+	}
+	cs.currentProposals[index].Labels = session.GetLabels(id)
+	cs.updateProposalDisplay()
+	cs.publishSession(session)
 	return nil
 }
 
+// closeLabelInput hides the label input and returns focus to the comparison
+func (cs *ComparisonScreen) closeLabelInput() {
+	if cs.editingLabels {
+		cs.editingLabels = false
+		cs.leftPanel.RemoveItem(cs.labelInput)
+	}
+	cs.updateStatus()
+	cs.setFocus(cs.container)
+}
+
+// completeLabel suggests labels used before for the label being typed (after the last comma)
+func (cs *ComparisonScreen) completeLabel(text string) []string {
+	session := cs.getSession()
+	if session == nil {
+		return nil
+	}
+
+	typed, current := "", text
+	if comma := strings.LastIndex(text, ","); comma >= 0 {
+		typed, current = text[:comma+1]+" ", text[comma+1:]
+	}
+	current = strings.ToLower(strings.TrimSpace(current))
+	if current == "" {
+		return nil
+	}
+
+	entries := make([]string, 0)
+	for _, label := range session.GetAllLabels() {
+		if strings.HasPrefix(strings.ToLower(label), current) {
+			entries = append(entries, strings.TrimLeft(typed, " ")+label)
+		}
+	}
+	return entries
+}
+
+// setFocus moves keyboard focus when the app exposes its tview application
+func (cs *ComparisonScreen) setFocus(primitive tview.Primitive) {
+	if app, ok := cs.app.(interface{ GetTViewApp() *tview.Application }); ok {
+		app.GetTViewApp().SetFocus(primitive)
+	}
+}
+
 // Helper methods
 
 // getSession gets the current session from the app
@@ -852,8 +1390,9 @@ func (cs *ComparisonScreen) getConfig() *data.SessionConfig {
 	return nil
 }
 
-// checkTopTConvergence checks if the top-T proposals have stabilized
-func (cs *ComparisonScreen) checkTopTConvergence() bool {
+// checkConvergence reports whether the session's convergence evaluation recommends
+// stopping, once early stopping is enabled
+func (cs *ComparisonScreen) checkConvergence() bool {
 	session := cs.getSession()
 	config := cs.getConfig()
 
@@ -861,91 +1400,8 @@ func (cs *ComparisonScreen) checkTopTConvergence() bool {
 		return false
 	}
 
-	// Need minimum comparisons before checking convergence
-	if session.TotalComparisons < config.Convergence.MinComparisons {
-		return false
-	}
-
-	// Get current proposals and sort by score to get rankings
-	proposals := session.GetProposals()
-	if len(proposals) < config.Convergence.TargetAccepted {
-		return false
-	}
-
-	// Sort proposals by score (descending) to get current rankings
-	sortedProposals := make([]data.Proposal, len(proposals))
-	copy(sortedProposals, proposals)
-
-	// Sort by score - higher scores rank better
-	for i := 0; i < len(sortedProposals)-1; i++ {
-		for j := i + 1; j < len(sortedProposals); j++ {
-			if sortedProposals[j].Score > sortedProposals[i].Score {
-				sortedProposals[i], sortedProposals[j] = sortedProposals[j], sortedProposals[i]
-			}
-		}
-	}
-
-	// Get top-T proposals
-	topT := make([]string, config.Convergence.TargetAccepted)
-	for i := 0; i < config.Convergence.TargetAccepted; i++ {
-		topT[i] = sortedProposals[i].ID
-	}
-
-	// Simple convergence check: if we have enough comparisons and clear rating separation
-	totalComparisons := session.TotalComparisons
-
-	// Enhanced convergence criteria for better confidence:
-	// 1. We have at least 2x the minimum comparisons
-	// 2. The top-T proposals have a clear rating gap from the rest
-	// 3. Each top-T proposal has participated in enough individual comparisons for confidence
-	if totalComparisons >= config.Convergence.MinComparisons*2 {
-		// Check rating gap between T-th and (T+1)-th proposal
-		if config.Convergence.TargetAccepted < len(sortedProposals) {
-			topTScore := sortedProposals[config.Convergence.TargetAccepted-1].Score
-			nextScore := sortedProposals[config.Convergence.TargetAccepted].Score
-			ratingGap := topTScore - nextScore
-
-			// Require significant rating gap
-			if ratingGap > config.Convergence.StabilityThreshold {
-				// Additional check: ensure top-T proposals have enough individual comparisons
-				// This ensures confidence levels are reasonable (aim for ~70%+ confidence)
-				// With trio mode, we want each top proposal to participate in 4-5 comparisons
-				// Using logarithmic confidence: 100 * (1 - e^(-count/5))
-				// 4 comparisons → ~55% confidence, 5 comparisons → ~63% confidence
-				minIndividualComparisons := 4 // Increased for better confidence levels
-				if cs.comparisonMethod == data.MethodQuartet {
-					minIndividualComparisons = 3 // Quartet is more efficient, can use lower minimum
-				}
-				for i := 0; i < config.Convergence.TargetAccepted; i++ {
-					proposalID := sortedProposals[i].ID
-					individualCount := cs.getProposalComparisonCount(proposalID, session)
-					if individualCount < minIndividualComparisons {
-						// Top proposal doesn't have enough individual comparisons yet
-						// This ensures confidence will be ≥55% (trio) or ≥45% (quartet)
-						return false
-					}
-				}
-				// All conditions met: rating gap + individual participation
-				return true
-			}
-		}
-	}
-
-	// Alternative: if we've done many comparisons, assume convergence
-	theoreticalMax := len(proposals) * (len(proposals) - 1) / 2
-	switch cs.comparisonMethod {
-	case data.MethodTrio:
-		theoreticalMax = (theoreticalMax + 2) / 3
-	case data.MethodQuartet:
-		theoreticalMax = (theoreticalMax + 5) / 6
-	}
-
-	// If we've done more than 80% of theoretical max, consider converged
-	if totalComparisons > int(float64(theoreticalMax)*0.8) {
-		return true
-	}
-
-	return false
+	metrics := session.GetConvergenceMetrics()
+	return metrics != nil && metrics.ShouldStop
 }
 
 // getProposalComparisonCount counts how many comparisons a proposal has participated in
@@ -985,21 +1441,46 @@ func (cs *ComparisonScreen) updateInstructions() {
 	instructions.WriteString("\n\n")
 
 	if cs.isRanking {
-		instructions.WriteString(fmt.Sprintf("[green]Ranking Mode: Assigning Rank %d[-]\n", cs.currentRank))
-		instructions.WriteString("Press the number of the proposal to assign this rank:\n")
+		picking := cs.comparisonMethod == data.MethodPickBest
+		switch {
+		case picking:
+			instructions.WriteString(fmt.Sprintf("[green]Picking: %d of %d picked[-]\n", len(cs.proposalRanks), cs.selectionTarget()))
+			instructions.WriteString("Press the number of a proposal to pick or unpick it:\n")
+		case cs.comparisonMethod == data.MethodRankTop:
+			instructions.WriteString(fmt.Sprintf("[green]Ranking Mode: Assigning Rank %d of %d[-]\n", min(cs.currentRank, cs.selectionTarget()), cs.selectionTarget()))
+			instructions.WriteString("Press the number of the proposal to assign this rank:\n")
+		default:
+			instructions.WriteString(fmt.Sprintf("[green]Ranking Mode: Assigning Rank %d[-]\n", cs.currentRank))
+			instructions.WriteString("Press the number of the proposal to assign this rank:\n")
+		}
 		for i := range cs.currentProposals {
 			// Show which proposals already have ranks
 			proposalID := cs.currentProposals[i].ID
-			if rank, hasRank := cs.proposalRanks[proposalID]; hasRank {
+			if rank, hasRank := cs.proposalRanks[proposalID]; hasRank && picking {
+				instructions.WriteString(fmt.Sprintf("  %d - Proposal %d [dim](Picked)[-]\n", i+1, i+1))
+			} else if hasRank {
 				instructions.WriteString(fmt.Sprintf("  %d - Proposal %d [dim](Rank %d)[-]\n", i+1, i+1, rank))
 			} else {
 				instructions.WriteString(fmt.Sprintf("  %d - Proposal %d\n", i+1, i+1))
 			}
 		}
-		instructions.WriteString("\n[yellow]u[-] - Undo last | [yellow]Enter[-] - Confirm (when all ranked)")
+		if cs.comparisonMethod.IsPartial() {
+			instructions.WriteString(fmt.Sprintf("\n[yellow]u[-] - Undo last | [yellow]Enter[-] - Confirm (when %d chosen)", cs.selectionTarget()))
+		} else {
+			instructions.WriteString("\n[yellow]u[-] - Undo last | [yellow]Enter[-] - Confirm (when all ranked)")
+		}
 	} else {
 		// Different instructions based on comparison method
-		if cs.comparisonMethod == data.MethodTrio || cs.comparisonMethod == data.MethodQuartet {
+		if cs.comparisonMethod.IsPartial() {
+			verb := "Rank the top"
+			if cs.comparisonMethod == data.MethodPickBest {
+				verb = "Pick the best"
+			}
+			instructions.WriteString(fmt.Sprintf("[white]%s %d of %d proposals:[-]\n", verb, cs.selectionTarget(), len(cs.currentProposals)))
+			for i := range cs.currentProposals {
+				instructions.WriteString(fmt.Sprintf("  %d - Proposal %d\n", i+1, i+1))
+			}
+		} else if cs.comparisonMethod == data.MethodTrio || cs.comparisonMethod == data.MethodQuartet {
 			instructions.WriteString("[white]Rank all proposals from best (1) to worst:[-]\n")
 			for i := range cs.currentProposals {
 				instructions.WriteString(fmt.Sprintf("  %d - Proposal %d\n", i+1, i+1))
@@ -1009,88 +1490,23 @@ func (cs *ComparisonScreen) updateInstructions() {
 			for i := range cs.currentProposals {
 				instructions.WriteString(fmt.Sprintf("  %d - Proposal %d\n", i+1, i+1))
 			}
+			instructions.WriteString("  = - Equally good\n")
 			instructions.WriteString("\n[blue]Or press 'r' to rank all[-]")
 		}
+		if cs.cardGrid != nil {
+			instructions.WriteString("\n\n[yellow]PgUp/PgDn[-] - Scroll proposals")
+		}
+		instructions.WriteString("\n\n[yellow]u[-] - Undo last comparison | [yellow]y[-] - Redo")
+		instructions.WriteString("\n[yellow]m[-] - " + map[bool]string{true: "Hide", false: "Show"}[cs.showProgressPanel] + " progress panel")
+		instructions.WriteString("\n[yellow]a[-] + number - Note on a proposal | [yellow]w[-] - Why (note on last comparison)")
+		instructions.WriteString("\n[yellow]l[-] + number - Label a proposal")
 	}
 
 	cs.controlPanel.SetText(instructions.String())
 }
 
-// calculateExpectedComparisons estimates realistic number of comparisons needed for convergence
-func (cs *ComparisonScreen) calculateExpectedComparisons(totalProposals int, config *data.SessionConfig) int {
-	if config == nil {
-		return totalProposals * 2 // Fallback: ~2 comparisons per proposal
-	}
-
-	// Calculate based on minimum comparisons per proposal and comparison method efficiency
-	minPerProposal := cs.calculateMinComparisonsForConfidence(totalProposals)
-
-	// Estimate total comparisons based on method
-	switch cs.comparisonMethod {
-	case data.MethodTrio:
-		// Trio covers 3 pairwise games per comparison
-		// Need minPerProposal comparisons per proposal, but trios cover 3 proposals each
-		return (totalProposals * minPerProposal) / 2 // Divide by 2 for overlap efficiency
-	case data.MethodQuartet:
-		// Quartet covers 6 pairwise games per comparison
-		// Most efficient method
-		return (totalProposals * minPerProposal) / 3 // Divide by 3 for higher overlap
-	default:
-		// Pairwise: need minPerProposal comparisons per proposal
-		return totalProposals * minPerProposal / 2 // Divide by 2 since each comparison involves 2 proposals
-	}
-}
-
-// calculateMinComparisonsForConfidence determines minimum comparisons per proposal for good confidence
-func (cs *ComparisonScreen) calculateMinComparisonsForConfidence(totalProposals int) int {
-	// For small datasets, we need relatively more comparisons for confidence
-	// For large datasets, we can get by with fewer per proposal
-
-	switch {
-	case totalProposals <= 5:
-		// Very small dataset: need each proposal compared multiple times
-		switch cs.comparisonMethod {
-		case data.MethodQuartet:
-			return 2 // 2 quartets means ~4 pairwise comparisons
-		case data.MethodTrio:
-			return 3 // 3 trios means ~4.5 pairwise comparisons
-		default:
-			return 4 // 4 pairwise comparisons
-		}
-	case totalProposals <= 20:
-		// Small dataset: moderate number of comparisons
-		switch cs.comparisonMethod {
-		case data.MethodQuartet:
-			return 3
-		case data.MethodTrio:
-			return 4
-		default:
-			return 5
-		}
-	case totalProposals <= 50:
-		// Medium dataset
-		switch cs.comparisonMethod {
-		case data.MethodQuartet:
-			return 4
-		case data.MethodTrio:
-			return 5
-		default:
-			return 6
-		}
-	default:
-		// Large dataset: can use fewer per proposal
-		switch cs.comparisonMethod {
-		case data.MethodQuartet:
-			return 5
-		case data.MethodTrio:
-			return 6
-		default:
-			return 8
-		}
-	}
-}
-
-// updateProgress updates the progress display with convergence-aware metrics
+// updateProgress updates the progress display with the session's convergence evaluation
+// (coverage, remaining estimate and stopping criteria) and refreshes the progress panel when shown
 func (cs *ComparisonScreen) updateProgress() {
 	session := cs.getSession()
 	if session == nil {
@@ -1098,88 +1514,61 @@ func (cs *ComparisonScreen) updateProgress() {
 	}
 
 	completed := session.TotalComparisons
-	totalProposals := len(session.Proposals)
+	metrics := session.GetConvergenceMetrics()
+	if metrics == nil {
+		cs.progressBar.SetText(fmt.Sprintf("Moves: %d", completed))
+		return
+	}
 
-	// Get convergence configuration
-	config := cs.getConfig()
-	var convergenceStatus string
+	progress := fmt.Sprintf("Moves: %d\nCoverage: %.0f%%\nRemaining: ~%d",
+		completed, metrics.CoveragePercentage, metrics.RemainingEstimate)
 
-	// Check if we have convergence configuration and early stopping is enabled
-	if config.Convergence.EnableEarlyStopping {
-		// Check top-T convergence instead of all comparisons
-		if cs.checkTopTConvergence() {
-			convergenceStatus = fmt.Sprintf(" [green]Top-%d Stable![-]", config.Convergence.TargetAccepted)
+	if sessionConfig := cs.getConfig(); sessionConfig != nil && sessionConfig.Convergence.EnableEarlyStopping {
+		progress += fmt.Sprintf("\nCriteria: %.0f%%", metrics.ConvergenceScore*100)
+		if metrics.ShouldStop {
+			progress += " [green]Stop![-]"
 		}
 	}
 
-	// Use convergence-aware display
-	var progress string
-	if config.Convergence.EnableEarlyStopping {
-		// Calculate realistic expected comparisons based on dataset size and method
-		// This gives a much better progress estimate than using MaxComparisons limit
-		expectedComparisons := cs.calculateExpectedComparisons(totalProposals, config)
-
-		// Progress toward expected convergence point (not hard max limit)
-		convergencePercent := float64(completed) / float64(expectedComparisons) * 100
-		if convergencePercent > 100 {
-			convergencePercent = 100
-		}
+	cs.progressBar.SetText(progress)
+	cs.refreshProgressPanel(session)
+}
 
-		// Calculate stability: what % of ALL proposals (not just top-T) meet criteria
-		stabilityProgress := 0.0
-		if completed >= config.Convergence.MinComparisons {
-			// Count how many proposals meet the stability criteria
-			sortedProposals := make([]data.Proposal, len(session.Proposals))
-			copy(sortedProposals, session.Proposals)
-			sort.Slice(sortedProposals, func(i, j int) bool {
-				return sortedProposals[i].Score > sortedProposals[j].Score
-			})
-
-			stableCount := 0
-			// Use the smaller of TargetAccepted or actual proposal count
-			// This prevents showing 67% when we only have 3 proposals
-			targetTop := min(config.Convergence.TargetAccepted, len(sortedProposals))
-
-			// Adjust minimum comparisons based on dataset size and method
-			minIndividualComparisons := cs.calculateMinComparisonsForConfidence(totalProposals)
-
-			for i := range targetTop {
-				proposalID := sortedProposals[i].ID
-				if cs.getProposalComparisonCount(proposalID, session) >= minIndividualComparisons {
-					stableCount++
-				}
-			}
+// toggleProgressPanel shows or hides the detailed progress panel below the proposals
+func (cs *ComparisonScreen) toggleProgressPanel() {
+	session := cs.getSession()
+	if session == nil {
+		return
+	}
 
-			stabilityProgress = float64(stableCount) / float64(targetTop) * 100
+	if cs.progressPanel == nil {
+		engine, err := newSessionEloEngine(session)
+		if err != nil {
+			return
 		}
+		cs.progressPanel = components.NewProgress(engine, components.DefaultProgressConfig())
+		cs.progressPanel.SetConfig(session.Config.Convergence.OptimizationConfig())
+	}
 
-		progress = fmt.Sprintf("Moves: %d\nProgress: %.0f%%\nStability: %.0f%%%s",
-			completed, convergencePercent, stabilityProgress, convergenceStatus)
+	cs.showProgressPanel = !cs.showProgressPanel
+	if cs.showProgressPanel {
+		cs.leftPanel.AddItem(cs.progressPanel.GetContainer(), progressPanelHeight, 0, false)
+		cs.refreshProgressPanel(session)
 	} else {
-		// Traditional completion percentage - calculate theoretical maximum
-		var maxComparisons int
-		switch cs.comparisonMethod {
-		case data.MethodPairwise:
-			maxComparisons = totalProposals * (totalProposals - 1) / 2
-		case data.MethodTrio:
-			totalPairwise := totalProposals * (totalProposals - 1) / 2
-			maxComparisons = (totalPairwise + 2) / 3 // Round up division
-		case data.MethodQuartet:
-			totalPairwise := totalProposals * (totalProposals - 1) / 2
-			maxComparisons = (totalPairwise + 5) / 6 // Round up division
-		default:
-			maxComparisons = totalProposals * (totalProposals - 1) / 2
-		}
-
-		percentage := float64(completed) / float64(maxComparisons) * 100
-		if percentage > 100 {
-			percentage = 100
-		}
-		progress = fmt.Sprintf("Comparisons: %d/%d (%.1f%%)",
-			completed, maxComparisons, percentage)
+		cs.leftPanel.RemoveItem(cs.progressPanel.GetContainer())
 	}
+	cs.updateInstructions()
+}
 
-	cs.progressBar.SetText(progress)
+// progressPanelHeight is the number of rows the progress panel takes below the proposals
+const progressPanelHeight = 16
+
+// refreshProgressPanel feeds the progress panel with the session's comparison history
+func (cs *ComparisonScreen) refreshProgressPanel(session *data.Session) {
+	if !cs.showProgressPanel || cs.progressPanel == nil {
+		return
+	}
+	cs.progressPanel.Update(session.GetProposals(), data.ComparisonHistoryOf(session.GetComparisonHistory()))
 }
 
 // updateStatus updates the status display
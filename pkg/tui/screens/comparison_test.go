@@ -0,0 +1,396 @@
+package screens
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+
+	"github.com/pashagolub/confelo/pkg/data"
+	"github.com/pashagolub/confelo/pkg/elo"
+)
+
+// newMultiWayTestScreen creates a comparison screen on a session of four proposals with distinct ratings
+func newMultiWayTestScreen(t *testing.T) (*ComparisonScreen, *data.Session) {
+	proposals := []data.Proposal{
+		{ID: "A", Title: "Alpha", Score: 1620},
+		{ID: "B", Title: "Beta", Score: 1540},
+		{ID: "C", Title: "Gamma", Score: 1480},
+		{ID: "D", Title: "Delta", Score: 1390},
+	}
+	session, err := data.NewSession("Multi-way", proposals, data.DefaultSessionConfig(), "test.csv")
+	if err != nil {
+		t.Fatalf("NewSession() failed: %v", err)
+	}
+
+	screen := NewComparisonScreen()
+	screen.app = &RankingMockAppWithSession{RankingMockApp: *newRankingMockApp(), session: session}
+	return screen, session
+}
+
+// rankInScreen submits a ranking of the given proposals through the screen's multi-way path
+func rankInScreen(t *testing.T, screen *ComparisonScreen, session *data.Session, method data.ComparisonMethod, ranking ...string) {
+	screen.comparisonMethod = method
+	screen.currentProposals = make([]data.Proposal, len(ranking))
+	for i, id := range ranking {
+		proposal, err := session.GetProposalByID(id)
+		if err != nil {
+			t.Fatalf("GetProposalByID(%s) failed: %v", id, err)
+		}
+		screen.currentProposals[len(ranking)-1-i] = *proposal // Presented in a different order than ranked
+	}
+	screen.rankings = ranking
+
+	if err := screen.executeMultiWayComparison(); err != nil {
+		t.Fatalf("executeMultiWayComparison() failed: %v", err)
+	}
+}
+
+func TestComparisonScreen_MultiWayMatchesEngine(t *testing.T) {
+	screen, session := newMultiWayTestScreen(t)
+	before := session.GetProposals()
+
+	rankInScreen(t, screen, session, data.MethodTrio, "C", "A", "D")
+
+	// The library rates the same ranking from the same ratings
+	eloConfig := session.Config.Elo
+	engine, err := elo.NewEngine(elo.Config{
+		InitialRating: eloConfig.InitialRating,
+		KFactor:       eloConfig.KFactor,
+		MinRating:     eloConfig.MinRating,
+		MaxRating:     eloConfig.MaxRating,
+	})
+	if err != nil {
+		t.Fatalf("NewEngine() failed: %v", err)
+	}
+	expected, _, err := engine.CalculateMultiway([]elo.Rating{
+		{ID: "C", Score: before[2].Score},
+		{ID: "A", Score: before[0].Score},
+		{ID: "D", Score: before[3].Score},
+	})
+	if err != nil {
+		t.Fatalf("CalculateMultiway() failed: %v", err)
+	}
+
+	history := session.GetComparisonHistory()
+	if len(history) != 1 {
+		t.Fatalf("Expected one recorded comparison, got %d", len(history))
+	}
+	updates := history[0].EloUpdates
+	if len(updates) != len(expected) {
+		t.Fatalf("Expected %d rating updates, got %d", len(expected), len(updates))
+	}
+
+	total := 0.0
+	for i, rating := range expected {
+		proposal, err := session.GetProposalByID(rating.ID)
+		if err != nil {
+			t.Fatalf("GetProposalByID(%s) failed: %v", rating.ID, err)
+		}
+		if proposal.Score != rating.Score {
+			t.Errorf("%s: TUI rating %v, library rating %v", rating.ID, proposal.Score, rating.Score)
+		}
+		if updates[i].ProposalID != rating.ID || updates[i].NewRating != rating.Score {
+			t.Errorf("Update %d: expected %s -> %v, got %s -> %v", i, rating.ID, rating.Score, updates[i].ProposalID, updates[i].NewRating)
+		}
+		if updates[i].KFactor != eloConfig.KFactor {
+			t.Errorf("Update %d: expected K-factor %d, got %d", i, eloConfig.KFactor, updates[i].KFactor)
+		}
+		total += updates[i].RatingDelta
+	}
+	if total > 1e-9 || total < -1e-9 {
+		t.Errorf("Expected rating points to be conserved, total change %v", total)
+	}
+
+	// The proposal left out of the trio keeps its rating
+	if proposal, _ := session.GetProposalByID("B"); proposal.Score != before[1].Score {
+		t.Errorf("Expected B to keep %v, got %v", before[1].Score, proposal.Score)
+	}
+}
+
+func TestComparisonScreen_MultiWayMatchesReplay(t *testing.T) {
+	screen, session := newMultiWayTestScreen(t)
+	before := session.GetProposals()
+
+	rankInScreen(t, screen, session, data.MethodQuartet, "D", "B", "A", "C")
+	rankInScreen(t, screen, session, data.MethodTrio, "B", "C", "D")
+	rankInScreen(t, screen, session, data.MethodQuartet, "A", "D", "C", "B")
+
+	// Replaying the recorded rankings with the library reproduces the session's ratings
+	engine, err := newSessionEloEngine(session)
+	if err != nil {
+		t.Fatalf("newSessionEloEngine() failed: %v", err)
+	}
+	ratings := make([]elo.Rating, len(before))
+	for i, proposal := range before {
+		ratings[i] = elo.Rating{ID: proposal.ID, Score: proposal.Score}
+	}
+	replayed, err := engine.Replay(ratings, data.ComparisonOutcomes(session.GetComparisonHistory()))
+	if err != nil {
+		t.Fatalf("Replay() failed: %v", err)
+	}
+
+	for i, proposal := range session.GetProposals() {
+		if proposal.Score != replayed[i].Score {
+			t.Errorf("%s: TUI rating %v, replayed rating %v", proposal.ID, proposal.Score, replayed[i].Score)
+		}
+	}
+
+	// Undo restores the ratings from the recorded updates
+	if _, err := session.UndoLastComparison(); err != nil {
+		t.Fatalf("UndoLastComparison() failed: %v", err)
+	}
+	replayed, err = engine.Replay(ratings, data.ComparisonOutcomes(session.GetComparisonHistory()))
+	if err != nil {
+		t.Fatalf("Replay() failed: %v", err)
+	}
+	for i, proposal := range session.GetProposals() {
+		if proposal.Score != replayed[i].Score {
+			t.Errorf("%s after undo: TUI rating %v, replayed rating %v", proposal.ID, proposal.Score, replayed[i].Score)
+		}
+	}
+}
+
+// newGroupTestScreen creates a comparison screen presenting six proposals of a session
+func newGroupTestScreen(t *testing.T, method data.ComparisonMethod, pickCount int) (*ComparisonScreen, *data.Session) {
+	proposals := []data.Proposal{
+		{ID: "A", Title: "Alpha", Score: 1620},
+		{ID: "B", Title: "Beta", Score: 1540},
+		{ID: "C", Title: "Gamma", Score: 1480},
+		{ID: "D", Title: "Delta", Score: 1390},
+		{ID: "E", Title: "Epsilon", Score: 1510},
+		{ID: "F", Title: "Zeta", Score: 1450},
+	}
+	session, err := data.NewSession("Group", proposals, data.DefaultSessionConfig(), "test.csv")
+	if err != nil {
+		t.Fatalf("NewSession() failed: %v", err)
+	}
+
+	screen := NewComparisonScreen()
+	screen.app = &RankingMockAppWithSession{RankingMockApp: *newRankingMockApp(), session: session}
+	screen.comparisonMethod = method
+	screen.pickCount = pickCount
+	screen.currentProposals = session.GetProposals()
+	screen.setupProposalCards(len(proposals))
+	return screen, session
+}
+
+// pressRunes sends key presses to the comparison screen
+func pressRunes(screen *ComparisonScreen, keys string) {
+	for _, key := range keys {
+		screen.handleInput(tcell.NewEventKey(tcell.KeyRune, key, tcell.ModNone))
+	}
+}
+
+// assertMatchesReplay checks that replaying the session's history reproduces its ratings
+func assertMatchesReplay(t *testing.T, session *data.Session, before []data.Proposal) {
+	engine, err := newSessionEloEngine(session)
+	if err != nil {
+		t.Fatalf("newSessionEloEngine() failed: %v", err)
+	}
+	ratings := make([]elo.Rating, len(before))
+	for i, proposal := range before {
+		ratings[i] = elo.Rating{ID: proposal.ID, Score: proposal.Score}
+	}
+	replayed, err := engine.Replay(ratings, data.ComparisonOutcomes(session.GetComparisonHistory()))
+	if err != nil {
+		t.Fatalf("Replay() failed: %v", err)
+	}
+	for i, proposal := range session.GetProposals() {
+		if proposal.Score != replayed[i].Score {
+			t.Errorf("%s: TUI rating %v, replayed rating %v", proposal.ID, proposal.Score, replayed[i].Score)
+		}
+	}
+}
+
+func TestComparisonScreen_PickBest(t *testing.T) {
+	screen, session := newGroupTestScreen(t, data.MethodPickBest, 2)
+	before := session.GetProposals()
+
+	// Picking 5, 4 and unpicking 4 leaves one pick; Enter waits for the second
+	pressRunes(screen, "544\r")
+	if !screen.isRanking || len(screen.proposalRanks) != 1 {
+		t.Fatalf("Expected one pick awaiting another, got %v", screen.proposalRanks)
+	}
+	pressRunes(screen, "3\r")
+
+	history := session.GetComparisonHistory()
+	if len(history) != 1 {
+		t.Fatalf("Expected one recorded comparison, got %d", len(history))
+	}
+	comparison := history[0]
+	if comparison.Method != data.MethodPickBest || comparison.Selected != 2 {
+		t.Errorf("Expected pick-best of 2, got %s of %d", comparison.Method, comparison.Selected)
+	}
+	expected := []string{"E", "C", "A", "B", "D", "F"}
+	for i, id := range expected {
+		if comparison.Rankings[i] != id {
+			t.Fatalf("Expected rankings %v, got %v", expected, comparison.Rankings)
+		}
+	}
+
+	// Picked proposals are not ordered against each other
+	results := session.GetProposalResults("E")
+	if len(results[0].Won) != 4 || len(results[0].Lost) != 0 {
+		t.Errorf("Expected E to beat the four unpicked proposals, got won %v lost %v", results[0].Won, results[0].Lost)
+	}
+	assertMatchesReplay(t, session, before)
+}
+
+func TestComparisonScreen_RankTop(t *testing.T) {
+	screen, session := newGroupTestScreen(t, data.MethodRankTop, 3)
+	before := session.GetProposals()
+
+	// A fourth rank is not accepted once the top three are ranked
+	pressRunes(screen, "6142")
+	if len(screen.proposalRanks) != 3 {
+		t.Fatalf("Expected three ranked proposals, got %v", screen.proposalRanks)
+	}
+	pressRunes(screen, "\r")
+
+	history := session.GetComparisonHistory()
+	if len(history) != 1 {
+		t.Fatalf("Expected one recorded comparison, got %d", len(history))
+	}
+	if history[0].Selected != 3 || history[0].WinnerID != "F" {
+		t.Errorf("Expected top 3 won by F, got %d won by %s", history[0].Selected, history[0].WinnerID)
+	}
+	outcome := data.ComparisonOutcomes(history)[0]
+	if !outcome.Unordered || outcome.Ranking[0] != "F" || outcome.Ranking[1] != "A" || outcome.Ranking[2] != "D" {
+		t.Errorf("Expected a partial outcome ranking F, A, D first, got %+v", outcome)
+	}
+	assertMatchesReplay(t, session, before)
+}
+
+func TestComparisonScreen_CardGrid(t *testing.T) {
+	screen, _ := newGroupTestScreen(t, data.MethodPickBest, 3)
+	if screen.cardGrid == nil || len(screen.proposalCards) != 6 {
+		t.Fatalf("Expected six cards in a grid, got %d", len(screen.proposalCards))
+	}
+
+	screen.scrollCards(5)
+	if rows, _ := screen.cardGrid.GetOffset(); rows != 2 {
+		t.Errorf("Expected scrolling to stop at the last row, got offset %d", rows)
+	}
+	screen.scrollCards(-5)
+	if rows, _ := screen.cardGrid.GetOffset(); rows != 0 {
+		t.Errorf("Expected scrolling to stop at the first row, got offset %d", rows)
+	}
+
+	screen.setupProposalCards(4)
+	if screen.cardGrid != nil {
+		t.Error("Expected a quartet to be laid out without the grid")
+	}
+}
+
+func TestComparisonScreen_Notes(t *testing.T) {
+	screen, session := newMultiWayTestScreen(t)
+	screen.currentProposals = session.GetProposals()[:2]
+	screen.updateProposalDisplay()
+
+	// 'a' and a number open the editor on that proposal; keys then go to the editor
+	pressRunes(screen, "a2")
+	if !screen.editingNote {
+		t.Fatal("Expected 'a2' to open the note editor")
+	}
+	if event := screen.handleInput(tcell.NewEventKey(tcell.KeyRune, 'u', tcell.ModNone)); event == nil {
+		t.Error("Expected keys to reach the open note editor")
+	}
+	screen.noteEditor.SetText("Great live demo")
+	screen.noteEditor.Save()
+
+	if screen.editingNote {
+		t.Error("Expected saving to close the note editor")
+	}
+	if got := session.GetNote("B"); got != "Great live demo" {
+		t.Errorf("Expected note on B, got %q", got)
+	}
+	if got := screen.currentProposals[1].Comment; got != "Great live demo" {
+		t.Errorf("Expected the shown proposal to carry the note, got %q", got)
+	}
+	if !strings.Contains(screen.proposalCards[1].GetText(true), "Great live demo") {
+		t.Error("Expected the card to show the note")
+	}
+
+	// 'a' followed by another key does nothing
+	pressRunes(screen, "a9")
+	if screen.editingNote || screen.pickProposal != nil {
+		t.Error("Expected an invalid proposal number to cancel the note")
+	}
+
+	// 'w' explains the last comparison
+	pressRunes(screen, "w")
+	if screen.editingNote {
+		t.Error("Expected no editor before any comparison")
+	}
+	rankInScreen(t, screen, session, data.MethodTrio, "A", "C", "D")
+	pressRunes(screen, "w")
+	if !screen.editingNote {
+		t.Fatal("Expected 'w' to open the note editor")
+	}
+	screen.noteEditor.SetText("Alpha had the clearest takeaway")
+	screen.noteEditor.Save()
+
+	history := session.GetComparisonHistory()
+	if got := history[len(history)-1].Note; got != "Alpha had the clearest takeaway" {
+		t.Errorf("Expected the note on the last comparison, got %q", got)
+	}
+
+	// Cancelling keeps the previous note
+	pressRunes(screen, "w")
+	screen.noteEditor.SetText("discarded")
+	screen.noteEditor.Cancel()
+	if got := session.GetComparisonHistory()[0].Note; got != "Alpha had the clearest takeaway" {
+		t.Errorf("Expected cancel to keep the note, got %q", got)
+	}
+}
+
+func TestComparisonScreen_Labels(t *testing.T) {
+	screen, session := newMultiWayTestScreen(t)
+	screen.currentProposals = session.GetProposals()[:2]
+	screen.updateProposalDisplay()
+	if err := session.SetLabels("C", []string{"keynote"}); err != nil {
+		t.Fatalf("SetLabels() failed: %v", err)
+	}
+
+	// 'l' and a number open the label input on that proposal; keys then go to the input
+	pressRunes(screen, "l1")
+	if !screen.editingLabels {
+		t.Fatal("Expected 'l1' to open the label input")
+	}
+	if event := screen.handleInput(tcell.NewEventKey(tcell.KeyRune, 'a', tcell.ModNone)); event == nil {
+		t.Error("Expected keys to reach the open label input")
+	}
+	if got := screen.completeLabel("beginner-friendly, key"); len(got) != 1 || got[0] != "beginner-friendly, keynote" {
+		t.Errorf("Expected labels used before to be suggested, got %v", got)
+	}
+	screen.labelInput.SetText("beginner-friendly, Keynote, keynote")
+	pressKey(screen.labelInput, tcell.KeyEnter)
+
+	if screen.editingLabels {
+		t.Error("Expected Enter to close the label input")
+	}
+	if got := session.GetLabels("A"); len(got) != 2 || got[0] != "beginner-friendly" || got[1] != "Keynote" {
+		t.Errorf("Expected labels on A, got %v", got)
+	}
+	if !strings.Contains(screen.proposalCards[0].GetText(true), "Labels: beginner-friendly, Keynote") {
+		t.Error("Expected the card to show the labels")
+	}
+
+	// Esc keeps the previous labels, an empty list clears them
+	pressRunes(screen, "l1")
+	screen.labelInput.SetText("discarded")
+	pressKey(screen.labelInput, tcell.KeyEscape)
+	if got := session.GetLabels("A"); len(got) != 2 {
+		t.Errorf("Expected Esc to keep the labels, got %v", got)
+	}
+	pressRunes(screen, "l1")
+	if got := screen.labelInput.GetText(); got != "beginner-friendly, Keynote" {
+		t.Errorf("Expected the input to show the labels, got %q", got)
+	}
+	screen.labelInput.SetText("")
+	pressKey(screen.labelInput, tcell.KeyEnter)
+	if got := session.GetLabels("A"); len(got) != 0 {
+		t.Errorf("Expected the labels to be cleared, got %v", got)
+	}
+}
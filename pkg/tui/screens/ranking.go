@@ -1,6 +1,6 @@
 // Package screens provides TUI screen implementations for conference talk ranking.
 // This file implements the ranking display screen where users view current rankings,
-// filter results, and initiate export operations.
+// filter results, open proposal details, and initiate export operations.
 package screens
 
 import (
@@ -14,6 +14,8 @@ import (
 	"github.com/rivo/tview"
 
 	"github.com/pashagolub/confelo/pkg/data"
+	"github.com/pashagolub/confelo/pkg/elo"
+	"github.com/pashagolub/confelo/pkg/tui/components"
 )
 
 // SortOrder represents the sorting direction for rankings
@@ -46,11 +48,150 @@ const (
 
 // FilterCriteria holds the current filtering settings
 type FilterCriteria struct {
-	SearchText    string   // Text to search in title/speaker/abstract
-	MinScore      float64  // Minimum Elo score
-	MaxScore      float64  // Maximum Elo score
-	MinConfidence float64  // Minimum confidence level
-	ConflictTags  []string // Filter by conflict tags
+	SearchText    string            // Text to search in title/speaker/abstract
+	Metadata      map[string]string // Required metadata values, e.g. track=dev
+	MinScore      float64           // Minimum Elo score
+	MaxScore      float64           // Maximum Elo score (0 = no maximum)
+	MinConfidence float64           // Minimum confidence level
+	ConflictTags  []string          // Filter by conflict tags
+	Labels        []string          // Filter by reviewer labels
+}
+
+// IsActive reports whether any criterion narrows the proposals
+func (f FilterCriteria) IsActive() bool {
+	return f.SearchText != "" || len(f.Metadata) > 0 || f.MinScore != 0 || f.MaxScore != 0 ||
+		f.MinConfidence > 0 || len(f.ConflictTags) > 0 || len(f.Labels) > 0
+}
+
+// Matches reports whether a proposal with the given confidence (0-100) passes the filter.
+// Text and metadata values match case-insensitively; a proposal passes the conflict tags
+// and the labels when it carries any of them.
+func (f FilterCriteria) Matches(proposal data.Proposal, confidence float64) bool {
+	if f.SearchText != "" {
+		search := strings.ToLower(f.SearchText)
+		if !strings.Contains(strings.ToLower(proposal.Title), search) &&
+			!strings.Contains(strings.ToLower(proposal.Speaker), search) &&
+			!strings.Contains(strings.ToLower(proposal.Abstract), search) {
+			return false
+		}
+	}
+
+	for key, value := range f.Metadata {
+		if !strings.EqualFold(metadataValue(proposal, key), value) {
+			return false
+		}
+	}
+
+	if proposal.Score < f.MinScore || (f.MaxScore != 0 && proposal.Score > f.MaxScore) {
+		return false
+	}
+	if confidence < f.MinConfidence {
+		return false
+	}
+
+	if len(f.ConflictTags) > 0 && !hasAny(f.ConflictTags, proposal.HasConflictTag) {
+		return false
+	}
+	if len(f.Labels) > 0 && !hasAny(f.Labels, proposal.HasLabel) {
+		return false
+	}
+
+	return true
+}
+
+// hasAny reports whether has holds for any of the values
+func hasAny(values []string, has func(string) bool) bool {
+	for _, value := range values {
+		if has(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// String describes the active criteria for the status bar
+func (f FilterCriteria) String() string {
+	parts := make([]string, 0, 5)
+	if f.SearchText != "" {
+		parts = append(parts, fmt.Sprintf("%q", f.SearchText))
+	}
+	if len(f.Metadata) > 0 {
+		parts = append(parts, formatMetadataFilter(f.Metadata))
+	}
+	switch {
+	case f.MinScore != 0 && f.MaxScore != 0:
+		parts = append(parts, fmt.Sprintf("score %.0f-%.0f", f.MinScore, f.MaxScore))
+	case f.MinScore != 0:
+		parts = append(parts, fmt.Sprintf("score >= %.0f", f.MinScore))
+	case f.MaxScore != 0:
+		parts = append(parts, fmt.Sprintf("score <= %.0f", f.MaxScore))
+	}
+	if f.MinConfidence > 0 {
+		parts = append(parts, fmt.Sprintf("confidence >= %.0f%%", f.MinConfidence))
+	}
+	if len(f.ConflictTags) > 0 {
+		parts = append(parts, "conflicts "+strings.Join(f.ConflictTags, ","))
+	}
+	if len(f.Labels) > 0 {
+		parts = append(parts, "labels "+strings.Join(f.Labels, ","))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// metadataValue returns a proposal's metadata value, matching the key case-insensitively
+func metadataValue(proposal data.Proposal, key string) string {
+	if value, exists := proposal.Metadata[key]; exists {
+		return value
+	}
+	for name, value := range proposal.Metadata {
+		if strings.EqualFold(name, key) {
+			return value
+		}
+	}
+	return ""
+}
+
+// parseMetadataFilter parses comma-separated key=value pairs such as "track=dev, level=advanced"
+func parseMetadataFilter(text string) (map[string]string, error) {
+	var metadata map[string]string
+	for _, pair := range strings.Split(text, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		key, value, found := strings.Cut(pair, "=")
+		key = strings.TrimSpace(key)
+		if !found || key == "" {
+			return nil, fmt.Errorf("metadata filter '%s' must be key=value", strings.TrimSpace(pair))
+		}
+		if metadata == nil {
+			metadata = make(map[string]string)
+		}
+		metadata[key] = strings.TrimSpace(value)
+	}
+	return metadata, nil
+}
+
+// formatMetadataFilter formats metadata criteria as sorted key=value pairs
+func formatMetadataFilter(metadata map[string]string) string {
+	pairs := make([]string, 0, len(metadata))
+	for key, value := range metadata {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ", ")
+}
+
+// parseFilterNumber parses an optional number of the filter panel (empty = 0)
+func parseFilterNumber(label, text string) (float64, error) {
+	text = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(text), "%"))
+	if text == "" {
+		return 0, nil
+	}
+	value, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s must be a number, got '%s'", strings.ToLower(label), text)
+	}
+	return value, nil
 }
 
 // RankingScreen implements the ranking display interface
@@ -69,6 +210,28 @@ type RankingScreen struct {
 	sortField   SortField
 	sortOrder   SortOrder
 	selectedRow int
+	ratingView  string                           // Reviewer whose individual ratings are shown (empty for pooled ratings)
+	btFit       bool                             // Whether scores come from the Bradley–Terry fit instead of Elo
+	fitErrors   map[string]float64               // Standard error of each Bradley–Terry rating by proposal ID
+	decisions   map[string]data.ProposalDecision // Accept/waitlist/reject band per proposal ID
+	groupedView bool                             // Whether proposals are listed per group with their cut lines
+
+	// Filtering
+	filter       FilterCriteria    // Active filter narrowing the ranking table
+	filtered     []data.Proposal   // Proposals passing the filter, in display order
+	searchInput  *tview.InputField // Search box opened with '/'
+	searchBefore string            // Search text restored when the search box is cancelled
+	searching    bool              // Whether the search box is shown
+	filterForm   *tview.Form       // Filter panel opened with 'f'
+	filtering    bool              // Whether the filter panel is shown
+
+	// Proposal details
+	detailView *tview.TextView // Detail pane opened with Enter on a row
+	detailID   string          // Proposal shown in the detail pane (empty when closed)
+
+	// Reviewer notes, edited with 'a' on a row or in the detail pane
+	noteEditor  *components.NoteEditor
+	editingNote bool // Whether the note editor is shown
 
 	// App reference
 	app any
@@ -81,6 +244,10 @@ func NewRankingScreen() *RankingScreen {
 		mainLayout:   tview.NewFlex(),
 		rankingTable: tview.NewTable(),
 		statusBar:    tview.NewTextView(),
+		searchInput:  tview.NewInputField(),
+		filterForm:   tview.NewForm(),
+		detailView:   tview.NewTextView(),
+		noteEditor:   components.NewNoteEditor(),
 
 		sortField:   SortByRank,
 		sortOrder:   SortAsc,
@@ -141,17 +308,60 @@ func (rs *RankingScreen) setupUI() {
 		SetTextAlign(tview.AlignLeft).
 		SetText("[blue]Ready - Use arrow keys to navigate, 'S' to sort[-]")
 
+	// Configure search box: the table narrows while typing, Enter keeps the search and Esc restores it
+	rs.searchInput.SetLabel("/").
+		SetFieldBackgroundColor(tcell.ColorDarkBlue).
+		SetChangedFunc(func(text string) {
+			rs.filter.SearchText = strings.TrimSpace(text)
+			rs.updateDisplay()
+		}).
+		SetDoneFunc(func(key tcell.Key) {
+			if key == tcell.KeyEscape {
+				rs.searchInput.SetText(rs.searchBefore)
+			}
+			rs.hideSearch()
+		})
+
+	// Configure filter panel
+	rs.filterForm.SetBorder(true).
+		SetTitle(" Filter ").
+		SetTitleAlign(tview.AlignCenter)
+	rs.filterForm.SetCancelFunc(rs.hideFilterPanel)
+
+	// Configure detail pane: Enter on a row opens it, n/p step through the rank order
+	rs.detailView.SetDynamicColors(true).
+		SetWordWrap(true).
+		SetBorder(true).
+		SetTitleAlign(tview.AlignCenter)
+	rs.rankingTable.SetSelectedFunc(func(row, column int) {
+		if row > 0 && row <= len(rs.filtered) {
+			rs.showDetail(rs.filtered[row-1].ID)
+		}
+	})
+
 	rs.mainLayout.SetDirection(tview.FlexColumn).
 		AddItem(rs.rankingTable, 0, 3, true)
 
-	rs.container.SetDirection(tview.FlexRow).
-		AddItem(rs.mainLayout, 0, 1, true).
-		AddItem(rs.statusBar, 1, 1, false)
+	rs.container.SetDirection(tview.FlexRow)
+	rs.layoutContainer()
+}
+
+// layoutContainer arranges the rankings, the search box while it is open and the status bar
+func (rs *RankingScreen) layoutContainer() {
+	rs.container.Clear().
+		AddItem(rs.mainLayout, 0, 1, true)
+	if rs.searching {
+		rs.container.AddItem(rs.searchInput, 1, 0, false)
+	}
+	rs.container.AddItem(rs.statusBar, 1, 1, false)
 }
 
 // setupTableHeaders configures the ranking table headers
 func (rs *RankingScreen) setupTableHeaders() {
-	headers := []string{"Rank", "Elo", "Score", "Confidence", "Title", "Speaker"}
+	headers := []string{"Rank", "Elo", "Score", "Confidence", "Title", "Speaker", "Decision"}
+	if groups := rs.getGroupConfig(); groups.Enabled() {
+		headers = append(headers, groups.Column)
+	}
 	for col, header := range headers {
 		cell := tview.NewTableCell(header).
 			SetTextColor(tcell.ColorYellow).
@@ -164,6 +374,34 @@ func (rs *RankingScreen) setupTableHeaders() {
 
 // setupKeyBindings configures keyboard shortcuts
 func (rs *RankingScreen) setupKeyBindings() {
+	rs.detailView.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEscape, tcell.KeyEnter:
+			rs.hideDetail()
+			return nil
+		case tcell.KeyRight:
+			rs.showNeighbour(1)
+			return nil
+		case tcell.KeyLeft:
+			rs.showNeighbour(-1)
+			return nil
+		}
+
+		switch event.Rune() {
+		case 'n', 'N':
+			rs.showNeighbour(1)
+			return nil
+		case 'p', 'P':
+			rs.showNeighbour(-1)
+			return nil
+		case 'a', 'A':
+			rs.editNote(rs.detailID, rs.detailView)
+			return nil
+		}
+
+		return event
+	})
+
 	rs.rankingTable.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
 		switch event.Rune() {
 		case 's', 'S':
@@ -172,6 +410,32 @@ func (rs *RankingScreen) setupKeyBindings() {
 		case 'o', 'O':
 			rs.toggleSortOrder()
 			return nil
+		case 'v', 'V':
+			rs.cycleRatingView()
+			return nil
+		case 'b', 'B':
+			rs.toggleRatingModel()
+			return nil
+		case 'g', 'G':
+			rs.toggleGroupedView()
+			return nil
+		case '/':
+			rs.showSearch()
+			return nil
+		case 'f', 'F':
+			rs.showFilterPanel()
+			return nil
+		case 'a', 'A':
+			if row, _ := rs.rankingTable.GetSelection(); row > 0 && row <= len(rs.filtered) {
+				rs.editNote(rs.filtered[row-1].ID, rs.rankingTable)
+			}
+			return nil
+		}
+
+		// Esc drops an active filter
+		if event.Key() == tcell.KeyEscape && rs.filter.IsActive() {
+			rs.clearFilter()
+			return nil
 		}
 
 		return event
@@ -188,7 +452,13 @@ func (rs *RankingScreen) loadProposals() error {
 		if err != nil {
 			return fmt.Errorf("failed to load proposals from session: %w", err)
 		}
-		rs.proposals = proposals
+		// Sort and re-score a copy so the session's proposal order and index stay intact
+		rs.proposals = make([]data.Proposal, len(proposals))
+		copy(rs.proposals, proposals)
+		if err := rs.applyRatingView(); err != nil {
+			return err
+		}
+		rs.updateDecisions()
 		return nil
 	}
 
@@ -207,66 +477,240 @@ func (rs *RankingScreen) loadProposals() error {
 	return fmt.Errorf("unable to access proposals from app")
 }
 
-// calculateConfidence estimates confidence based on number of comparisons
-func (rs *RankingScreen) calculateConfidence(proposal data.Proposal) float64 {
-	// Try to get comparison count from the app's session data
-	if appInterface, ok := rs.app.(interface{ GetComparisonCount(proposalID string) int }); ok {
-		count := appInterface.GetComparisonCount(proposal.ID)
-
-		// Get total number of proposals to adjust confidence scaling
-		totalProposals := len(rs.proposals)
-
-		// Base confidence on number of comparisons, adjusted for dataset size
-		// For small datasets, fewer comparisons are needed for good confidence
-		// For large datasets, more comparisons are needed
-		var targetComparisons float64
-		switch {
-		case totalProposals <= 5:
-			// Small dataset: 2-3 comparisons is reasonable
-			targetComparisons = 2.5
-		case totalProposals <= 20:
-			// Medium dataset: 4-5 comparisons
-			targetComparisons = 4.5
-		case totalProposals <= 50:
-			// Larger dataset: 6-8 comparisons
-			targetComparisons = 7.0
-		default:
-			// Very large dataset: 10+ comparisons
-			targetComparisons = 10.0
-		}
-
-		// Calculate confidence using adjusted scale
-		// confidence = 100 * (1 - e^(-count/target))
-		// This gives:
-		// - For target=2.5: 1 comp≈33%, 2≈55%, 3≈70%, 5≈86%
-		// - For target=4.5: 3 comp≈48%, 5≈67%, 7≈78%, 10≈89%
-		baseConfidence := 100.0 * (1.0 - math.Exp(-float64(count)/targetComparisons))
-
-		// Apply smaller penalties for small datasets
-		if totalProposals <= 5 {
-			// For very small datasets, be less strict
-			if count < 2 {
-				baseConfidence *= 0.85 // Only 15% penalty
-			}
-		} else {
-			// For larger datasets, apply standard penalties
-			if count < 3 {
-				baseConfidence *= 0.7
-			}
+// notReviewedText marks proposals excluded from the reviewer's comparisons by conflicts
+const notReviewedText = "not reviewed by you"
+
+// isConflicted reports whether a proposal is excluded by the reviewer's conflict tags
+func (rs *RankingScreen) isConflicted(proposalID string) bool {
+	session := rs.getSession()
+	return session != nil && session.IsConflicted(proposalID)
+}
+
+// getSession returns the current session if the app exposes one
+func (rs *RankingScreen) getSession() *data.Session {
+	if appInterface, ok := rs.app.(interface{ GetSession() *data.Session }); ok {
+		return appInterface.GetSession()
+	}
+	return nil
+}
+
+// applyRatingView replaces pooled scores with the selected reviewer's individual ratings
+// or the Bradley–Terry fit. Individual ratings replay only that reviewer's comparisons
+// from the proposals' starting ratings.
+func (rs *RankingScreen) applyRatingView() error {
+	rs.fitErrors = nil
+	if rs.ratingView == "" && !rs.btFit {
+		return nil
+	}
+
+	session := rs.getSession()
+	if session == nil {
+		rs.ratingView = ""
+		rs.btFit = false
+		return nil
+	}
+
+	if rs.btFit {
+		return rs.applyBradleyTerryFit(session)
+	}
+
+	engine, err := newSessionEngine(session)
+	if err != nil {
+		return fmt.Errorf("failed to create rating engine: %w", err)
+	}
+
+	startingRatings := session.GetStartingRatings()
+	ratings := make([]elo.Rating, 0, len(rs.proposals))
+	for _, proposal := range rs.proposals {
+		rating := engine.NewRating(proposal.ID)
+		rating.Score = startingRatings[proposal.ID]
+		ratings = append(ratings, rating)
+	}
+
+	replayed, err := engine.Replay(ratings, data.ComparisonOutcomes(session.GetReviewerComparisons(rs.ratingView)))
+	if err != nil {
+		return fmt.Errorf("failed to replay comparisons of %s: %w", rs.ratingView, err)
+	}
+
+	for i := range rs.proposals {
+		rs.proposals[i].Score = replayed[i].Score
+		rs.proposals[i].Deviation = replayed[i].Deviation
+		rs.proposals[i].Volatility = replayed[i].Volatility
+	}
+
+	return nil
+}
+
+// applyBradleyTerryFit replaces scores with an order-independent Plackett–Luce fit of the
+// comparisons in the current rating view; the standard errors are kept apart from the
+// Glicko-2 rating deviations
+func (rs *RankingScreen) applyBradleyTerryFit(session *data.Session) error {
+	comparisons := session.GetComparisonHistory()
+	if rs.ratingView != "" {
+		comparisons = session.GetReviewerComparisons(rs.ratingView)
+	}
+
+	outcomes := data.ComparisonOutcomes(comparisons)
+
+	ids := make([]string, len(rs.proposals))
+	for i, proposal := range rs.proposals {
+		ids[i] = proposal.ID
+	}
+
+	config := elo.DefaultFitConfig()
+	config.InitialRating = sessionEloConfig(session).InitialRating
+	fit, err := elo.FitPlackettLuce(ids, outcomes, config)
+	if err != nil {
+		return fmt.Errorf("failed to fit Bradley–Terry model: %w", err)
+	}
+
+	rs.fitErrors = make(map[string]float64, len(fit.Ratings))
+	for i, rating := range fit.Ratings {
+		rs.proposals[i].Score = rating.Rating
+		rs.proposals[i].Deviation = 0
+		rs.proposals[i].Volatility = 0
+		rs.fitErrors[rating.ID] = rating.StdError
+	}
+
+	return nil
+}
+
+// updateDecisions assigns accept/waitlist/reject bands to the displayed ratings,
+// using the comparisons of the current rating view for their uncertainty
+func (rs *RankingScreen) updateDecisions() {
+	session := rs.getSession()
+	if session == nil {
+		rs.decisions = nil
+		return
+	}
+
+	comparisons := session.GetComparisonHistory()
+	if rs.ratingView != "" {
+		comparisons = session.GetReviewerComparisons(rs.ratingView)
+	}
+
+	uncertainties := data.RatingUncertainties(rs.proposals, comparisons, sessionEloConfig(session).InitialRating)
+	rs.decisions = data.DecideGroupedProposals(rs.proposals, uncertainties, session.Config.Convergence, session.Config.Groups)
+}
+
+// getGroupConfig returns the session's grouping (disabled without a session)
+func (rs *RankingScreen) getGroupConfig() data.GroupConfig {
+	if session := rs.getSession(); session != nil {
+		return session.Config.Groups
+	}
+	return data.GroupConfig{}
+}
+
+// toggleGroupedView switches between the overall ranking and per-group rankings
+func (rs *RankingScreen) toggleGroupedView() {
+	if !rs.getGroupConfig().Enabled() {
+		return
+	}
+
+	rs.groupedView = !rs.groupedView
+	rs.sortProposals()
+	rs.updateDisplay()
+}
+
+// isCutLine reports whether a proposal is the last one accepted in its group
+func (rs *RankingScreen) isCutLine(proposal data.Proposal) bool {
+	decision, decided := rs.decisions[proposal.ID]
+	if !decided || decision.Decision != data.DecisionAccepted {
+		return false
+	}
+
+	groups := rs.getGroupConfig()
+	group := groups.GroupOf(proposal)
+	accepted := 0
+	for _, other := range rs.proposals {
+		if groups.GroupOf(other) == group && rs.decisions[other.ID].Decision == data.DecisionAccepted {
+			accepted++
 		}
+	}
+	return decision.Rank == accepted
+}
 
-		// Check if this proposal has the same score as others nearby (within ±1 rating)
-		// This indicates a tie situation with high uncertainty
-		if rs.hasSimilarScores(proposal, 1.0) {
-			// For small datasets with ties, only small penalty
-			if totalProposals <= 5 && count >= 2 {
-				baseConfidence *= 0.9 // Only 10% penalty if we have some comparisons
-			} else if count < 5 {
-				baseConfidence *= 0.8 // Larger penalty for larger datasets or few comparisons
-			}
+// toggleRatingModel switches between Elo ratings and the Bradley–Terry fit
+func (rs *RankingScreen) toggleRatingModel() {
+	if rs.getSession() == nil {
+		return
+	}
+
+	rs.btFit = !rs.btFit
+	if err := rs.loadProposals(); err != nil {
+		rs.statusBar.SetText(fmt.Sprintf("[red]Failed to switch rating model: %v[-]", err))
+		return
+	}
+	rs.sortProposals()
+	rs.updateDisplay()
+}
+
+// getRatingModelName returns a display name for the current rating model
+func (rs *RankingScreen) getRatingModelName() string {
+	if rs.btFit {
+		return "BT fit"
+	}
+	return "Elo"
+}
+
+// cycleRatingView switches between pooled ratings and each reviewer's individual ratings
+func (rs *RankingScreen) cycleRatingView() {
+	session := rs.getSession()
+	if session == nil {
+		return
+	}
+
+	// Order: pooled, then reviewers alphabetically, then back to pooled
+	views := append([]string{""}, session.GetReviewers()...)
+	next := 0
+	for i, view := range views {
+		if view == rs.ratingView {
+			next = (i + 1) % len(views)
+			break
 		}
+	}
+	rs.ratingView = views[next]
+
+	if err := rs.loadProposals(); err != nil {
+		rs.statusBar.SetText(fmt.Sprintf("[red]Failed to switch rating view: %v[-]", err))
+		return
+	}
+	rs.sortProposals()
+	rs.updateDisplay()
+}
+
+// getRatingViewName returns a display name for the current rating view
+func (rs *RankingScreen) getRatingViewName() string {
+	if rs.ratingView == "" {
+		return "Pooled"
+	}
+	return rs.ratingView
+}
 
-		return math.Min(baseConfidence, 100.0)
+// ratingError returns the uncertainty shown next to a rating: the standard error of the
+// Bradley–Terry fit or the Glicko-2 rating deviation (0 when neither applies)
+func (rs *RankingScreen) ratingError(proposal data.Proposal) float64 {
+	if stdError, fitted := rs.fitErrors[proposal.ID]; fitted {
+		return stdError
+	}
+	return proposal.Deviation
+}
+
+// calculateConfidence estimates confidence based on number of comparisons
+func (rs *RankingScreen) calculateConfidence(proposal data.Proposal) float64 {
+	// The Bradley–Terry fit measures how far the standard error shrank below the prior's
+	if stdError, fitted := rs.fitErrors[proposal.ID]; fitted {
+		return 100.0 * math.Max(0, math.Min(1, 1-stdError/elo.DefaultFitConfig().PriorStdError()))
+	}
+
+	// Glicko-2 tracks rating uncertainty directly
+	if proposal.Deviation > 0 {
+		return data.ProposalConfidence(proposal, 0, rs.proposals)
+	}
+
+	// Try to get comparison count from the app's session data
+	if appInterface, ok := rs.app.(interface{ GetComparisonCount(proposalID string) int }); ok {
+		return data.ProposalConfidence(proposal, appInterface.GetComparisonCount(proposal.ID), rs.proposals)
 	}
 
 	// Fallback: estimate confidence based on score deviation from default
@@ -423,6 +867,14 @@ func (rs *RankingScreen) sortProposals() {
 
 		return result
 	})
+
+	// Per-group rankings keep the chosen order within each group
+	if rs.groupedView {
+		groups := rs.getGroupConfig()
+		sort.SliceStable(rs.proposals, func(i, j int) bool {
+			return groups.GroupOf(rs.proposals[i]) < groups.GroupOf(rs.proposals[j])
+		})
+	}
 }
 
 // updateDisplay refreshes the ranking table with current data
@@ -431,37 +883,62 @@ func (rs *RankingScreen) updateDisplay() {
 	rs.rankingTable.Clear()
 	rs.setupTableHeaders()
 
-	// Add proposal rows
-	for row, proposal := range rs.proposals {
-		rs.addProposalRow(row+1, proposal) // +1 for header row
+	// Add rows of the proposals passing the filter, keeping their rank among all proposals
+	rs.filtered = make([]data.Proposal, 0, len(rs.proposals))
+	for i, proposal := range rs.proposals {
+		if !rs.matchesFilter(proposal) {
+			continue
+		}
+		rs.filtered = append(rs.filtered, proposal)
+		rs.addProposalRow(len(rs.filtered), i+1, proposal) // row 0 is the header
+	}
+
+	// Show which ratings are displayed
+	title := fmt.Sprintf(" Rankings (%s, %s) ", rs.getRatingViewName(), rs.getRatingModelName())
+	if rs.groupedView {
+		title = fmt.Sprintf(" Rankings per %s (%s, %s) ", rs.getGroupConfig().Column, rs.getRatingViewName(), rs.getRatingModelName())
 	}
+	rs.rankingTable.SetTitle(title)
 
-	// Update status
+	// Update status and the open detail pane
 	rs.updateStatusBar()
+	if rs.detailID != "" {
+		rs.renderDetail()
+	}
 
 	// Ensure valid selection
-	if rs.selectedRow >= len(rs.proposals) {
-		rs.selectedRow = len(rs.proposals) - 1
+	if rs.selectedRow >= len(rs.filtered) {
+		rs.selectedRow = len(rs.filtered) - 1
 	}
 	if rs.selectedRow < 0 {
 		rs.selectedRow = 0
 	}
 
-	if len(rs.proposals) > 0 {
+	if len(rs.filtered) > 0 {
 		rs.rankingTable.Select(rs.selectedRow+1, 0) // +1 for header
 	}
 }
 
 // addProposalRow adds a single proposal row to the table
-func (rs *RankingScreen) addProposalRow(row int, proposal data.Proposal) {
-	// Rank (1-based)
-	rs.rankingTable.SetCell(row, 0,
-		tview.NewTableCell(strconv.Itoa(row)).
-			SetAlign(tview.AlignCenter).
-			SetTextColor(tcell.ColorWhite))
+func (rs *RankingScreen) addProposalRow(row, rank int, proposal data.Proposal) {
+	// Rank (1-based, within the group in the grouped view), banded by the acceptance decision
+	decision, decided := rs.decisions[proposal.ID]
+	if rs.groupedView && decided {
+		rank = decision.Rank
+	}
+	rankCell := tview.NewTableCell(strconv.Itoa(rank)).
+		SetAlign(tview.AlignCenter).
+		SetTextColor(tcell.ColorWhite)
+	if decided {
+		rankCell.SetBackgroundColor(getDecisionColor(decision.Decision))
+	}
+	rs.rankingTable.SetCell(row, 0, rankCell)
 
 	// Score (formatted to 1 decimal place)
 	scoreText := fmt.Sprintf("%.1f", proposal.Score)
+	if ratingError := rs.ratingError(proposal); ratingError > 0 {
+		scoreText = fmt.Sprintf("%.1f ±%.0f", proposal.Score, ratingError)
+	}
 	scoreColor := rs.getScoreColor(proposal.Score)
 	rs.rankingTable.SetCell(row, 1,
 		tview.NewTableCell(scoreText).
@@ -476,10 +953,14 @@ func (rs *RankingScreen) addProposalRow(row int, proposal data.Proposal) {
 			SetAlign(tview.AlignCenter).
 			SetTextColor(scoreColor)) // Use same color as regular score
 
-	// Confidence indicator
+	// Confidence indicator (proposals excluded by the reviewer's conflicts were never judged by them)
 	confidence := rs.calculateConfidence(proposal)
 	confidenceText := fmt.Sprintf("%.0f%%", confidence)
 	confidenceColor := rs.getConfidenceColor(confidence)
+	if rs.isConflicted(proposal.ID) {
+		confidenceText = notReviewedText
+		confidenceColor = tcell.ColorGray
+	}
 	rs.rankingTable.SetCell(row, 3,
 		tview.NewTableCell(confidenceText).
 			SetAlign(tview.AlignCenter).
@@ -496,6 +977,49 @@ func (rs *RankingScreen) addProposalRow(row int, proposal data.Proposal) {
 		tview.NewTableCell(proposal.Speaker).
 			SetAlign(tview.AlignLeft).
 			SetTextColor(tcell.ColorLightBlue))
+
+	// Decision (borderline proposals are marked for committee discussion)
+	if decided {
+		decisionText := string(decision.Decision)
+		if decision.Borderline {
+			decisionText += " " + borderlineMark
+		}
+		rs.rankingTable.SetCell(row, 6,
+			tview.NewTableCell(decisionText).
+				SetAlign(tview.AlignLeft).
+				SetTextColor(getDecisionColor(decision.Decision)))
+	}
+
+	// Group, with the acceptance cut line drawn under the group's last accepted proposal
+	if groups := rs.getGroupConfig(); groups.Enabled() {
+		rs.rankingTable.SetCell(row, 7,
+			tview.NewTableCell(groups.GroupOf(proposal)).
+				SetAlign(tview.AlignLeft).
+				SetTextColor(tcell.ColorLightBlue))
+
+		if rs.groupedView && rs.isCutLine(proposal) {
+			for col := 0; col < rs.rankingTable.GetColumnCount(); col++ {
+				if cell := rs.rankingTable.GetCell(row, col); cell != nil {
+					cell.SetAttributes(tcell.AttrUnderline)
+				}
+			}
+		}
+	}
+}
+
+// borderlineMark flags decisions whose rating interval overlaps the acceptance cutoff
+const borderlineMark = "*"
+
+// getDecisionColor returns the band color of an acceptance decision
+func getDecisionColor(decision data.Decision) tcell.Color {
+	switch decision {
+	case data.DecisionAccepted:
+		return tcell.ColorGreen
+	case data.DecisionWaitlist:
+		return tcell.ColorYellow
+	default:
+		return tcell.ColorRed
+	}
 }
 
 // getScoreColor returns appropriate color for a score value
@@ -525,8 +1049,42 @@ func (rs *RankingScreen) updateStatusBar() {
 	sortFieldName := []string{"Rank", "Score", "Export", "Title", "Speaker", "Confidence"}[rs.sortField]
 	sortOrderName := map[SortOrder]string{SortAsc: "↑", SortDesc: "↓"}[rs.sortOrder]
 
-	status := fmt.Sprintf("[blue]S: Sort (%s) | O: Order (%s) | Use arrow keys to navigate[-]",
-		sortFieldName, sortOrderName)
+	status := fmt.Sprintf("[blue]S: Sort (%s) | O: Order (%s) | V: View (%s) | B: Model (%s) | /: Search | F: Filter | Enter: Details | Use arrow keys to navigate[-]",
+		sortFieldName, sortOrderName, rs.getRatingViewName(), rs.getRatingModelName())
+	if rs.getGroupConfig().Enabled() {
+		status += fmt.Sprintf(" [blue]| G: Per %s (%s)[-]", rs.getGroupConfig().Column, map[bool]string{true: "on", false: "off"}[rs.groupedView])
+	}
+
+	// Show the active filter; exports from this screen only include the shown proposals
+	if rs.filter.IsActive() {
+		status += fmt.Sprintf(" [yellow]| Filter: %s (%d of %d shown, Esc clears)[-]",
+			tview.Escape(rs.filter.String()), len(rs.filtered), len(rs.proposals))
+	}
+
+	// Report proposals the reviewer could not judge because of conflicts
+	conflicted := 0
+	for _, proposal := range rs.proposals {
+		if rs.isConflicted(proposal.ID) {
+			conflicted++
+		}
+	}
+	if conflicted > 0 {
+		status += fmt.Sprintf(" [gray]| %d %s[-]", conflicted, notReviewedText)
+	}
+
+	// Summarise the acceptance decisions
+	if len(rs.decisions) > 0 {
+		counts := make(map[data.Decision]int)
+		borderline := 0
+		for _, decision := range rs.decisions {
+			counts[decision.Decision]++
+			if decision.Borderline {
+				borderline++
+			}
+		}
+		status += fmt.Sprintf(" [green]| %d accepted[-] [yellow]%d waitlist[-] [red]%d rejected[-] [white]%d borderline %s[-]",
+			counts[data.DecisionAccepted], counts[data.DecisionWaitlist], counts[data.DecisionRejected], borderline, borderlineMark)
+	}
 	rs.statusBar.SetText(status)
 }
 
@@ -547,3 +1105,441 @@ func (rs *RankingScreen) toggleSortOrder() {
 	rs.sortProposals()
 	rs.updateDisplay()
 }
+
+// matchesFilter reports whether a proposal passes the active filter
+func (rs *RankingScreen) matchesFilter(proposal data.Proposal) bool {
+	// Confidence is only worked out when it narrows the proposals
+	confidence := 100.0
+	if rs.filter.MinConfidence > 0 {
+		confidence = rs.calculateConfidence(proposal)
+	}
+	return rs.filter.Matches(proposal, confidence)
+}
+
+// FilteredProposalIDs returns the IDs of the proposals shown in ranking order and
+// whether a filter narrows them, so exports from this screen can respect the filter
+func (rs *RankingScreen) FilteredProposalIDs() ([]string, bool) {
+	ids := make([]string, len(rs.filtered))
+	for i, proposal := range rs.filtered {
+		ids[i] = proposal.ID
+	}
+	return ids, rs.filter.IsActive()
+}
+
+// setFocus moves keyboard focus when the app exposes its tview application
+func (rs *RankingScreen) setFocus(primitive tview.Primitive) {
+	if appInterface, ok := rs.app.(interface{ GetTViewApp() *tview.Application }); ok {
+		appInterface.GetTViewApp().SetFocus(primitive)
+	}
+}
+
+// showSearch opens the search box with the current search text
+func (rs *RankingScreen) showSearch() {
+	rs.searchBefore = rs.filter.SearchText
+	rs.searchInput.SetText(rs.filter.SearchText)
+	rs.searching = true
+	rs.layoutContainer()
+	rs.setFocus(rs.searchInput)
+}
+
+// hideSearch closes the search box and returns focus to the rankings
+func (rs *RankingScreen) hideSearch() {
+	rs.searching = false
+	rs.layoutContainer()
+	rs.setFocus(rs.rankingTable)
+}
+
+// Labels of the filter panel fields
+const (
+	filterSearchLabel     = "Search"
+	filterMetadataLabel   = "Metadata (key=value)"
+	filterMinScoreLabel   = "Min score"
+	filterMaxScoreLabel   = "Max score"
+	filterConfidenceLabel = "Min confidence %"
+	filterConflictsLabel  = "Conflict tags"
+	filterLabelsLabel     = "Labels"
+)
+
+// showFilterPanel opens the filter panel beside the rankings, filled with the active filter
+func (rs *RankingScreen) showFilterPanel() {
+	formatNumber := func(value float64) string {
+		if value == 0 {
+			return ""
+		}
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	}
+
+	rs.filterForm.Clear(true).
+		AddInputField(filterSearchLabel, rs.filter.SearchText, 0, nil, nil).
+		AddInputField(filterMetadataLabel, formatMetadataFilter(rs.filter.Metadata), 0, nil, nil).
+		AddInputField(filterMinScoreLabel, formatNumber(rs.filter.MinScore), 0, nil, nil).
+		AddInputField(filterMaxScoreLabel, formatNumber(rs.filter.MaxScore), 0, nil, nil).
+		AddInputField(filterConfidenceLabel, formatNumber(rs.filter.MinConfidence), 0, nil, nil).
+		AddInputField(filterConflictsLabel, strings.Join(rs.filter.ConflictTags, ", "), 0, nil, nil).
+		AddInputField(filterLabelsLabel, strings.Join(rs.filter.Labels, ", "), 0, nil, nil).
+		AddButton("Apply", rs.applyFilterPanel).
+		AddButton("Clear", func() {
+			rs.clearFilter()
+			rs.hideFilterPanel()
+		}).
+		AddButton("Close", rs.hideFilterPanel)
+
+	rs.closeDetail()
+	if !rs.filtering {
+		rs.filtering = true
+		rs.mainLayout.AddItem(rs.filterForm, 0, 2, true)
+	}
+	rs.setFocus(rs.filterForm)
+}
+
+// hideFilterPanel closes the filter panel and returns focus to the rankings
+func (rs *RankingScreen) hideFilterPanel() {
+	if rs.filtering {
+		rs.filtering = false
+		rs.mainLayout.RemoveItem(rs.filterForm)
+	}
+	rs.setFocus(rs.rankingTable)
+}
+
+// applyFilterPanel applies the filter panel's criteria; invalid input keeps the panel open
+func (rs *RankingScreen) applyFilterPanel() {
+	filter, err := rs.filterFromPanel()
+	if err != nil {
+		rs.statusBar.SetText(fmt.Sprintf("[red]Invalid filter: %v[-]", tview.Escape(err.Error())))
+		return
+	}
+
+	rs.filter = filter
+	rs.hideFilterPanel()
+	rs.updateDisplay()
+}
+
+// filterFromPanel reads the filter criteria from the filter panel's fields
+func (rs *RankingScreen) filterFromPanel() (FilterCriteria, error) {
+	text := func(label string) string {
+		if field, ok := rs.filterForm.GetFormItemByLabel(label).(*tview.InputField); ok {
+			return field.GetText()
+		}
+		return ""
+	}
+
+	var filter FilterCriteria
+	var err error
+	filter.SearchText = strings.TrimSpace(text(filterSearchLabel))
+	if filter.Metadata, err = parseMetadataFilter(text(filterMetadataLabel)); err != nil {
+		return FilterCriteria{}, err
+	}
+	if filter.MinScore, err = parseFilterNumber(filterMinScoreLabel, text(filterMinScoreLabel)); err != nil {
+		return FilterCriteria{}, err
+	}
+	if filter.MaxScore, err = parseFilterNumber(filterMaxScoreLabel, text(filterMaxScoreLabel)); err != nil {
+		return FilterCriteria{}, err
+	}
+	if filter.MaxScore != 0 && filter.MaxScore < filter.MinScore {
+		return FilterCriteria{}, fmt.Errorf("max score %.0f is below min score %.0f", filter.MaxScore, filter.MinScore)
+	}
+	if filter.MinConfidence, err = parseFilterNumber("Min confidence", text(filterConfidenceLabel)); err != nil {
+		return FilterCriteria{}, err
+	}
+	if tags := data.ParseConflictTags(text(filterConflictsLabel)); len(tags) > 0 {
+		filter.ConflictTags = tags
+	}
+	if labels := data.ParseLabels(text(filterLabelsLabel)); len(labels) > 0 {
+		filter.Labels = labels
+	}
+	return filter, nil
+}
+
+// clearFilter drops all filter criteria
+func (rs *RankingScreen) clearFilter() {
+	rs.filter = FilterCriteria{}
+	rs.updateDisplay()
+}
+
+// editNote opens the note editor beside the rankings for a proposal; focus returns to back when it closes
+func (rs *RankingScreen) editNote(proposalID string, back tview.Primitive) {
+	session := rs.getSession()
+	if session == nil || proposalID == "" {
+		return
+	}
+
+	title := proposalID
+	for _, proposal := range rs.proposals {
+		if proposal.ID == proposalID {
+			title = proposal.Title
+			break
+		}
+	}
+
+	rs.noteEditor.Edit(fmt.Sprintf("Note on %s", tview.Escape(title)), session.GetNote(proposalID), func(note string) {
+		rs.closeNoteEditor(back)
+		if err := session.SetNote(proposalID, note); err != nil {
+			rs.statusBar.SetText(fmt.Sprintf("[red]Error saving note: %v[-]", tview.Escape(err.Error())))
+			return
+		}
+		for i := range rs.proposals {
+			if rs.proposals[i].ID == proposalID {
+				rs.proposals[i].Comment = session.GetNote(proposalID)
+			}
+		}
+		rs.updateDisplay()
+	}, func() {
+		rs.closeNoteEditor(back)
+	})
+
+	if rs.filtering {
+		rs.filtering = false
+		rs.mainLayout.RemoveItem(rs.filterForm)
+	}
+	if !rs.editingNote {
+		rs.editingNote = true
+		rs.mainLayout.AddItem(rs.noteEditor.GetPrimitive(), 0, 2, true)
+	}
+	rs.setFocus(rs.noteEditor.GetPrimitive())
+}
+
+// closeNoteEditor hides the note editor and returns focus to the given primitive
+func (rs *RankingScreen) closeNoteEditor(back tview.Primitive) {
+	if rs.editingNote {
+		rs.editingNote = false
+		rs.mainLayout.RemoveItem(rs.noteEditor.GetPrimitive())
+	}
+	rs.setFocus(back)
+}
+
+// showDetail opens the detail pane for a proposal
+func (rs *RankingScreen) showDetail(proposalID string) {
+	if rs.filtering {
+		rs.filtering = false
+		rs.mainLayout.RemoveItem(rs.filterForm)
+	}
+	if rs.detailID == "" {
+		rs.mainLayout.AddItem(rs.detailView, 0, 2, true)
+	}
+
+	rs.detailID = proposalID
+	rs.renderDetail()
+	rs.detailView.ScrollToBeginning()
+	rs.setFocus(rs.detailView)
+}
+
+// hideDetail closes the detail pane and returns focus to the rankings
+func (rs *RankingScreen) hideDetail() {
+	rs.closeDetail()
+	rs.setFocus(rs.rankingTable)
+}
+
+// closeDetail removes the detail pane from the layout
+func (rs *RankingScreen) closeDetail() {
+	if rs.detailID != "" {
+		rs.detailID = ""
+		rs.mainLayout.RemoveItem(rs.detailView)
+	}
+}
+
+// rankOrder returns the shown proposals ordered by score, best first
+func (rs *RankingScreen) rankOrder() []data.Proposal {
+	ranked := make([]data.Proposal, len(rs.filtered))
+	copy(ranked, rs.filtered)
+	sort.SliceStable(ranked, func(i, j int) bool { return ranked[i].Score > ranked[j].Score })
+	return ranked
+}
+
+// showNeighbour moves the detail pane to the next (1) or previous (-1) proposal in rank order
+// and selects its row in the table
+func (rs *RankingScreen) showNeighbour(step int) {
+	ranked := rs.rankOrder()
+	for i, proposal := range ranked {
+		if proposal.ID != rs.detailID {
+			continue
+		}
+		next := i + step
+		if next < 0 || next >= len(ranked) {
+			return
+		}
+		rs.detailID = ranked[next].ID
+		rs.renderDetail()
+		rs.detailView.ScrollToBeginning()
+
+		for row, shown := range rs.filtered {
+			if shown.ID == rs.detailID {
+				rs.selectedRow = row
+				rs.rankingTable.Select(row+1, 0) // +1 for header
+				break
+			}
+		}
+		return
+	}
+}
+
+// renderDetail fills the detail pane with the abstract, metadata, conflict tags, rating
+// history and comparison results of the shown proposal
+func (rs *RankingScreen) renderDetail() {
+	var proposal *data.Proposal
+	titles := make(map[string]string, len(rs.proposals))
+	for i := range rs.proposals {
+		titles[rs.proposals[i].ID] = rs.proposals[i].Title
+		if rs.proposals[i].ID == rs.detailID {
+			proposal = &rs.proposals[i]
+		}
+	}
+	if proposal == nil {
+		rs.hideDetail()
+		return
+	}
+
+	// Rank among all proposals by the displayed score
+	rank := 1
+	for _, other := range rs.proposals {
+		if other.Score > proposal.Score {
+			rank++
+		}
+	}
+
+	var content strings.Builder
+	content.WriteString(fmt.Sprintf("[yellow::b]%s[-::-]\n", tview.Escape(proposal.Title)))
+	if proposal.Speaker != "" {
+		content.WriteString(fmt.Sprintf("[lightblue]%s[-]\n", tview.Escape(proposal.Speaker)))
+	}
+	content.WriteString(fmt.Sprintf("\n[blue]Rank:[-] %d of %d   [blue]%s:[-] %.1f", rank, len(rs.proposals), rs.getRatingModelName(), proposal.Score))
+	if ratingError := rs.ratingError(*proposal); ratingError > 0 {
+		content.WriteString(fmt.Sprintf(" ±%.0f", ratingError))
+	}
+	if rs.isConflicted(proposal.ID) {
+		content.WriteString("   [blue]Confidence:[-] " + notReviewedText)
+	} else {
+		content.WriteString(fmt.Sprintf("   [blue]Confidence:[-] %.0f%%", rs.calculateConfidence(*proposal)))
+	}
+	if decision, decided := rs.decisions[proposal.ID]; decided {
+		content.WriteString(fmt.Sprintf("   [blue]Decision:[-] %s", decision.Decision))
+		if decision.Borderline {
+			content.WriteString(" " + borderlineMark)
+		}
+	}
+	if session := rs.getSession(); session != nil {
+		if bucket := session.GetBucket(proposal.ID); bucket != data.BucketNone {
+			content.WriteString(fmt.Sprintf("   [blue]Triage:[-] %s", bucket))
+		}
+	}
+	content.WriteString("\n")
+
+	if proposal.Abstract != "" {
+		content.WriteString(fmt.Sprintf("\n[green]Abstract:[-]\n%s\n", tview.Escape(proposal.Abstract)))
+	}
+
+	if len(proposal.Metadata) > 0 {
+		keys := make([]string, 0, len(proposal.Metadata))
+		for key := range proposal.Metadata {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		content.WriteString("\n[cyan]Metadata:[-]\n")
+		for _, key := range keys {
+			content.WriteString(fmt.Sprintf("  %s: %s\n", tview.Escape(key), tview.Escape(proposal.Metadata[key])))
+		}
+	}
+
+	if len(proposal.ConflictTags) > 0 {
+		content.WriteString(fmt.Sprintf("\n[red]Conflicts:[-] %s\n", tview.Escape(strings.Join(proposal.ConflictTags, ", "))))
+	}
+
+	if len(proposal.Labels) > 0 {
+		content.WriteString(fmt.Sprintf("\n[fuchsia]Labels:[-] %s\n", tview.Escape(strings.Join(proposal.Labels, ", "))))
+	}
+
+	if proposal.Comment != "" {
+		content.WriteString(fmt.Sprintf("\n[cyan]Note:[-] %s\n", tview.Escape(proposal.Comment)))
+	}
+
+	var results []data.ProposalResult
+	if session := rs.getSession(); session != nil {
+		results = session.GetProposalResults(proposal.ID)
+	}
+
+	if history := data.RatingHistory(results); len(history) > 1 {
+		content.WriteString(fmt.Sprintf("\n[blue]Elo rating history:[-] %s %.0f → %.0f\n",
+			sparkline(history, detailSparklineWidth), history[0], history[len(history)-1]))
+	}
+
+	content.WriteString(rs.formatResults(results, titles))
+
+	rs.detailView.SetTitle(fmt.Sprintf(" #%d %s (n/p: next/previous, a: note, Esc: close) ", rank, tview.Escape(proposal.ID)))
+	rs.detailView.SetText(content.String())
+}
+
+// formatResults lists the comparisons a proposal won, lost and drew, naming the other proposals
+func (rs *RankingScreen) formatResults(results []data.ProposalResult, titles map[string]string) string {
+	if len(results) == 0 {
+		return "\n[gray]No comparisons yet[-]\n"
+	}
+
+	won, lost, drawn := 0, 0, 0
+	var lines strings.Builder
+	names := func(ids []string) string {
+		named := make([]string, len(ids))
+		for i, id := range ids {
+			named[i] = tview.Escape(titles[id])
+			if named[i] == "" {
+				named[i] = tview.Escape(id)
+			}
+		}
+		return strings.Join(named, ", ")
+	}
+
+	for _, result := range results {
+		reviewer := ""
+		if result.Reviewer != "" {
+			reviewer = fmt.Sprintf(" [gray](%s)[-]", tview.Escape(result.Reviewer))
+		}
+		if len(result.Won) > 0 {
+			won++
+			lines.WriteString(fmt.Sprintf("  [green]won[-]   vs %s%s\n", names(result.Won), reviewer))
+		}
+		if len(result.Lost) > 0 {
+			lost++
+			lines.WriteString(fmt.Sprintf("  [red]lost[-]  vs %s%s\n", names(result.Lost), reviewer))
+		}
+		if len(result.Drawn) > 0 {
+			drawn++
+			lines.WriteString(fmt.Sprintf("  [yellow]drew[-]  vs %s%s\n", names(result.Drawn), reviewer))
+		}
+		if result.Note != "" {
+			lines.WriteString(fmt.Sprintf("        [gray]why: %s[-]\n", tview.Escape(result.Note)))
+		}
+	}
+
+	return fmt.Sprintf("\n[blue]Comparisons:[-] %d (%d won, %d lost, %d drawn)\n%s", len(results), won, lost, drawn, lines.String())
+}
+
+// detailSparklineWidth is the number of most recent ratings drawn in the detail pane
+const detailSparklineWidth = 40
+
+// sparklineBlocks are the bar heights of a sparkline, lowest first
+var sparklineBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// sparkline draws the last width values as a one-line bar chart scaled to their range
+func sparkline(values []float64, width int) string {
+	if len(values) > width {
+		values = values[len(values)-width:]
+	}
+	if len(values) == 0 {
+		return ""
+	}
+
+	low, high := values[0], values[0]
+	for _, value := range values {
+		low = math.Min(low, value)
+		high = math.Max(high, value)
+	}
+
+	bars := make([]rune, len(values))
+	for i, value := range values {
+		level := len(sparklineBlocks) / 2
+		if high > low {
+			level = int((value - low) / (high - low) * float64(len(sparklineBlocks)-1))
+		}
+		bars[i] = sparklineBlocks[level]
+	}
+	return string(bars)
+}
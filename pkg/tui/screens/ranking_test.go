@@ -1,9 +1,15 @@
 package screens
 
 import (
+	"math"
+	"strings"
 	"testing"
 
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+
 	"github.com/pashagolub/confelo/pkg/data"
+	"github.com/pashagolub/confelo/pkg/elo"
 )
 
 // RankingMockApp implements the interfaces that RankingScreen expects from the app
@@ -234,6 +240,20 @@ func TestRankingScreen_CalculateConfidence(t *testing.T) {
 	}
 }
 
+func TestRankingScreen_CalculateConfidenceFromDeviation(t *testing.T) {
+	screen := NewRankingScreen()
+	screen.app = &RankingMockApp{comparisonCounts: map[string]int{"1": 0}, calls: make([]string, 0)}
+
+	// Glicko-2 deviation takes precedence over the comparison count heuristic
+	if conf := screen.calculateConfidence(data.Proposal{ID: "1", Score: 1500.0, Deviation: 175.0}); conf != 50.0 {
+		t.Errorf("Expected 50%% confidence at half the initial deviation, got %.2f", conf)
+	}
+
+	if conf := screen.calculateConfidence(data.Proposal{ID: "1", Score: 1500.0, Deviation: 350.0}); conf != 0.0 {
+		t.Errorf("Expected no confidence at the initial deviation, got %.2f", conf)
+	}
+}
+
 func TestRankingScreen_SortProposals(t *testing.T) {
 	screen := NewRankingScreen()
 	screen.proposals = []data.Proposal{
@@ -565,3 +585,684 @@ func TestRankingScreen_FormatExportScore(t *testing.T) {
 		}
 	})
 }
+
+// RankingMockAppWithSession extends RankingMockApp with a real session
+type RankingMockAppWithSession struct {
+	RankingMockApp
+	session *data.Session
+}
+
+func (m *RankingMockAppWithSession) GetSession() *data.Session {
+	m.calls = append(m.calls, "GetSession")
+	return m.session
+}
+
+func (m *RankingMockAppWithSession) GetProposals() ([]data.Proposal, error) {
+	m.calls = append(m.calls, "GetProposals")
+	return m.session.Proposals, nil
+}
+
+func TestRankingScreen_CycleRatingView(t *testing.T) {
+	proposals := []data.Proposal{
+		{ID: "A", Title: "Alpha", Score: 1500},
+		{ID: "B", Title: "Beta", Score: 1500},
+		{ID: "C", Title: "Gamma", Score: 1500},
+	}
+	session, err := data.NewSession("Committee", proposals, data.DefaultSessionConfig(), "test.csv")
+	if err != nil {
+		t.Fatalf("NewSession() failed: %v", err)
+	}
+
+	// Pooled ratings reflect both reviewers; alice prefers A, bob prefers C
+	record := func(reviewer, winner, loser string) {
+		session.SetReviewer(reviewer)
+		winnerProposal, _ := session.GetProposalByID(winner)
+		loserProposal, _ := session.GetProposalByID(loser)
+		err := session.RecordComparison(data.Comparison{
+			ProposalIDs: []string{winner, loser},
+			WinnerID:    winner,
+			Method:      data.MethodPairwise,
+			EloUpdates: []data.EloUpdate{
+				{ProposalID: winner, OldRating: winnerProposal.Score, NewRating: winnerProposal.Score + 16, RatingDelta: 16, KFactor: 32},
+				{ProposalID: loser, OldRating: loserProposal.Score, NewRating: loserProposal.Score - 16, RatingDelta: -16, KFactor: 32},
+			},
+		})
+		if err != nil {
+			t.Fatalf("RecordComparison() failed: %v", err)
+		}
+	}
+	record("alice", "A", "B")
+	record("bob", "C", "B")
+	record("bob", "C", "A")
+
+	screen := NewRankingScreen()
+	mockApp := &RankingMockAppWithSession{RankingMockApp: *newRankingMockApp(), session: session}
+	if err := screen.OnEnter(mockApp); err != nil {
+		t.Fatalf("OnEnter() failed: %v", err)
+	}
+
+	if screen.getRatingViewName() != "Pooled" {
+		t.Errorf("Expected pooled view by default, got %s", screen.getRatingViewName())
+	}
+	if screen.proposals[0].ID != "C" {
+		t.Errorf("Expected C to lead pooled ratings, got %s", screen.proposals[0].ID)
+	}
+
+	// First reviewer view replays only alice's comparison
+	screen.cycleRatingView()
+	if screen.ratingView != "alice" {
+		t.Fatalf("Expected alice view, got %q", screen.ratingView)
+	}
+	if screen.proposals[0].ID != "A" {
+		t.Errorf("Expected A to lead alice's ratings, got %s", screen.proposals[0].ID)
+	}
+	for _, proposal := range screen.proposals {
+		if proposal.ID == "C" && proposal.Score != 1500 {
+			t.Errorf("Expected C untouched in alice's ratings, got %.1f", proposal.Score)
+		}
+	}
+
+	screen.cycleRatingView()
+	if screen.ratingView != "bob" {
+		t.Fatalf("Expected bob view, got %q", screen.ratingView)
+	}
+	if screen.proposals[0].ID != "C" {
+		t.Errorf("Expected C to lead bob's ratings, got %s", screen.proposals[0].ID)
+	}
+
+	// Cycling wraps around to pooled ratings
+	screen.cycleRatingView()
+	if screen.ratingView != "" {
+		t.Errorf("Expected pooled view after cycling, got %q", screen.ratingView)
+	}
+
+	// Reviewer views must never change the session's pooled scores
+	proposalA, _ := session.GetProposalByID("A")
+	if proposalA.Score != 1500 {
+		t.Errorf("Expected pooled score of A to stay 1500, got %.1f", proposalA.Score)
+	}
+}
+
+func TestRankingScreen_ConflictedProposals(t *testing.T) {
+	proposals := []data.Proposal{
+		{ID: "A", Title: "Alpha", Score: 1600},
+		{ID: "B", Title: "Beta", Score: 1500, ConflictTags: []string{"acme"}},
+	}
+	session, err := data.NewSession("Conflicts", proposals, data.DefaultSessionConfig(), "test.csv")
+	if err != nil {
+		t.Fatalf("NewSession() failed: %v", err)
+	}
+	session.SetConflicts([]string{"acme"})
+
+	screen := NewRankingScreen()
+	mockApp := &RankingMockAppWithSession{RankingMockApp: *newRankingMockApp(), session: session}
+	if err := screen.OnEnter(mockApp); err != nil {
+		t.Fatalf("OnEnter() failed: %v", err)
+	}
+
+	// Rows follow the rank order: A (row 1), B (row 2); column 3 holds confidence
+	if text := screen.rankingTable.GetCell(1, 3).Text; text == notReviewedText {
+		t.Errorf("Expected confidence for A, got %q", text)
+	}
+	if text := screen.rankingTable.GetCell(2, 3).Text; text != notReviewedText {
+		t.Errorf("Expected %q for B, got %q", notReviewedText, text)
+	}
+	if status := screen.statusBar.GetText(true); !strings.Contains(status, "1 "+notReviewedText) {
+		t.Errorf("Expected status bar to report conflicted proposals, got %q", status)
+	}
+}
+
+func TestRankingScreen_ToggleRatingModel(t *testing.T) {
+	proposals := []data.Proposal{
+		{ID: "A", Title: "Alpha", Score: 1500},
+		{ID: "B", Title: "Beta", Score: 1500},
+		{ID: "C", Title: "Gamma", Score: 1500},
+	}
+	session, err := data.NewSession("Cycle", proposals, data.DefaultSessionConfig(), "test.csv")
+	if err != nil {
+		t.Fatalf("NewSession() failed: %v", err)
+	}
+
+	// A rock-paper-scissors cycle: Elo depends on the order, the fit does not
+	for _, pair := range [][2]string{{"A", "B"}, {"B", "C"}, {"C", "A"}} {
+		winner, _ := session.GetProposalByID(pair[0])
+		loser, _ := session.GetProposalByID(pair[1])
+		err := session.RecordComparison(data.Comparison{
+			ProposalIDs: []string{pair[0], pair[1]},
+			WinnerID:    pair[0],
+			Method:      data.MethodPairwise,
+			EloUpdates: []data.EloUpdate{
+				{ProposalID: pair[0], OldRating: winner.Score, NewRating: winner.Score + 16, RatingDelta: 16, KFactor: 32},
+				{ProposalID: pair[1], OldRating: loser.Score, NewRating: loser.Score - 16, RatingDelta: -16, KFactor: 32},
+			},
+		})
+		if err != nil {
+			t.Fatalf("RecordComparison() failed: %v", err)
+		}
+	}
+
+	screen := NewRankingScreen()
+	mockApp := &RankingMockAppWithSession{RankingMockApp: *newRankingMockApp(), session: session}
+	if err := screen.OnEnter(mockApp); err != nil {
+		t.Fatalf("OnEnter() failed: %v", err)
+	}
+	if screen.getRatingModelName() != "Elo" {
+		t.Errorf("Expected Elo model by default, got %s", screen.getRatingModelName())
+	}
+
+	screen.toggleRatingModel()
+	if screen.getRatingModelName() != "BT fit" {
+		t.Fatalf("Expected BT fit model after toggling, got %s", screen.getRatingModelName())
+	}
+	for _, proposal := range screen.proposals {
+		if math.Abs(proposal.Score-1500) > 1e-6 {
+			t.Errorf("Expected fitted rating 1500 for %s in a balanced cycle, got %.4f", proposal.ID, proposal.Score)
+		}
+		if proposal.Deviation != 0 {
+			t.Errorf("Expected no rating deviation for %s in the BT view, got %.2f", proposal.ID, proposal.Deviation)
+		}
+	}
+
+	// Confidence comes from the fit's standard error relative to the prior's
+	config := elo.DefaultFitConfig()
+	fit, err := elo.FitPlackettLuce([]string{"A", "B", "C"}, data.ComparisonOutcomes(session.GetComparisonHistory()), config)
+	if err != nil {
+		t.Fatalf("FitPlackettLuce() failed: %v", err)
+	}
+	for i, rating := range fit.Ratings {
+		proposal := screen.proposals[i]
+		if stdError := screen.ratingError(proposal); math.Abs(stdError-rating.StdError) > 1e-9 {
+			t.Errorf("Expected standard error %.4f for %s, got %.4f", rating.StdError, proposal.ID, stdError)
+		}
+		expected := 100.0 * (1 - rating.StdError/config.PriorStdError())
+		if confidence := screen.calculateConfidence(proposal); math.Abs(confidence-expected) > 1e-9 {
+			t.Errorf("Expected BT confidence %.4f for %s, got %.4f", expected, proposal.ID, confidence)
+		}
+	}
+
+	screen.toggleRatingModel()
+	for _, proposal := range screen.proposals {
+		stored, _ := session.GetProposalByID(proposal.ID)
+		if proposal.Score != stored.Score {
+			t.Errorf("Expected Elo score %.1f for %s after toggling back, got %.1f", stored.Score, proposal.ID, proposal.Score)
+		}
+		if stdError := screen.ratingError(proposal); stdError != 0 {
+			t.Errorf("Expected no standard error for %s after toggling back, got %.2f", proposal.ID, stdError)
+		}
+	}
+}
+
+func TestRankingScreen_DecisionBands(t *testing.T) {
+	proposals := []data.Proposal{
+		{ID: "A", Title: "Alpha", Score: 1700},
+		{ID: "B", Title: "Beta", Score: 1600},
+		{ID: "C", Title: "Gamma", Score: 1600},
+		{ID: "D", Title: "Delta", Score: 1300},
+	}
+	config := data.DefaultSessionConfig()
+	config.Convergence.TargetAccepted = 2
+	config.Convergence.WaitlistSize = 1
+	session, err := data.NewSession("Decisions", proposals, config, "test.csv")
+	if err != nil {
+		t.Fatalf("NewSession() failed: %v", err)
+	}
+
+	screen := NewRankingScreen()
+	mockApp := &RankingMockAppWithSession{RankingMockApp: *newRankingMockApp(), session: session}
+	if err := screen.OnEnter(mockApp); err != nil {
+		t.Fatalf("OnEnter() failed: %v", err)
+	}
+
+	// Rows follow the rank order: A, B, C, D; column 6 holds the decision.
+	// B and C tie across the cutoff, so they are borderline whatever their uncertainty.
+	expected := []struct {
+		text  string
+		color tcell.Color
+	}{
+		{"accepted", tcell.ColorGreen},
+		{"accepted " + borderlineMark, tcell.ColorGreen},
+		{"waitlist " + borderlineMark, tcell.ColorYellow},
+		{"rejected", tcell.ColorRed},
+	}
+	for i, want := range expected {
+		cell := screen.rankingTable.GetCell(i+1, 6)
+		if !strings.HasPrefix(cell.Text, want.text) {
+			t.Errorf("Row %d: expected decision %q, got %q", i+1, want.text, cell.Text)
+		}
+		if _, background, _ := screen.rankingTable.GetCell(i+1, 0).Style.Decompose(); background != want.color {
+			t.Errorf("Row %d: expected rank band %v, got %v", i+1, want.color, background)
+		}
+	}
+	if status := screen.statusBar.GetText(true); !strings.Contains(status, "2 accepted") {
+		t.Errorf("Expected status bar to summarise decisions, got %q", status)
+	}
+}
+
+func TestRankingScreen_GroupedView(t *testing.T) {
+	proposals := []data.Proposal{
+		{ID: "A", Title: "Alpha", Score: 1700, Metadata: map[string]string{"track": "dev"}},
+		{ID: "B", Title: "Beta", Score: 1650, Metadata: map[string]string{"track": "ops"}},
+		{ID: "C", Title: "Gamma", Score: 1600, Metadata: map[string]string{"track": "dev"}},
+		{ID: "D", Title: "Delta", Score: 1300, Metadata: map[string]string{"track": "ops"}},
+	}
+	config := data.DefaultSessionConfig()
+	config.Groups = data.GroupConfig{Column: "track", Quotas: map[string]int{"dev": 1, "ops": 1}}
+	session, err := data.NewSession("Tracks", proposals, config, "test.csv")
+	if err != nil {
+		t.Fatalf("NewSession() failed: %v", err)
+	}
+
+	screen := NewRankingScreen()
+	mockApp := &RankingMockAppWithSession{RankingMockApp: *newRankingMockApp(), session: session}
+	if err := screen.OnEnter(mockApp); err != nil {
+		t.Fatalf("OnEnter() failed: %v", err)
+	}
+
+	// Decisions follow the group quotas: C outrates the ops talk D but dev accepts only A
+	if text := screen.rankingTable.GetCell(0, 7).Text; text != "track" {
+		t.Errorf("Expected group column header 'track', got %q", text)
+	}
+	if decision := screen.decisions["C"].Decision; decision != data.DecisionWaitlist {
+		t.Errorf("Expected C on the dev waitlist, got %s", decision)
+	}
+
+	screen.toggleGroupedView()
+	if !screen.groupedView {
+		t.Fatal("Expected grouped view after toggling")
+	}
+
+	// Rows: dev (A, C), then ops (B, D); ranks restart per group
+	expected := []struct {
+		id      string
+		rank    string
+		cutLine bool
+	}{
+		{"A", "1", true},
+		{"C", "2", false},
+		{"B", "1", true},
+		{"D", "2", false},
+	}
+	for i, want := range expected {
+		if screen.proposals[i].ID != want.id {
+			t.Errorf("Row %d: expected %s, got %s", i+1, want.id, screen.proposals[i].ID)
+		}
+		rankCell := screen.rankingTable.GetCell(i+1, 0)
+		if rankCell.Text != want.rank {
+			t.Errorf("Row %d: expected group rank %s, got %s", i+1, want.rank, rankCell.Text)
+		}
+		if _, _, attributes := rankCell.Style.Decompose(); (attributes&tcell.AttrUnderline != 0) != want.cutLine {
+			t.Errorf("Row %d: expected cut line %v", i+1, want.cutLine)
+		}
+	}
+}
+
+func TestRankingScreen_GroupedViewRequiresGrouping(t *testing.T) {
+	screen := NewRankingScreen()
+	screen.toggleGroupedView()
+	if screen.groupedView {
+		t.Error("Expected grouped view to stay off without grouping")
+	}
+}
+
+func TestFilterCriteria_Matches(t *testing.T) {
+	proposal := data.Proposal{
+		ID:           "1",
+		Title:        "Advanced Go Patterns",
+		Speaker:      "Alice Johnson",
+		Abstract:     "Interfaces, generics and concurrency.",
+		Score:        1650,
+		Metadata:     map[string]string{"Track": "Dev"},
+		ConflictTags: []string{"acme"},
+		Labels:       []string{"Beginner-Friendly"},
+	}
+
+	tests := []struct {
+		name       string
+		filter     FilterCriteria
+		confidence float64
+		expected   bool
+	}{
+		{"empty filter", FilterCriteria{}, 0, true},
+		{"title text", FilterCriteria{SearchText: "go patterns"}, 0, true},
+		{"speaker text", FilterCriteria{SearchText: "ALICE"}, 0, true},
+		{"abstract text", FilterCriteria{SearchText: "generics"}, 0, true},
+		{"missing text", FilterCriteria{SearchText: "kubernetes"}, 0, false},
+		{"metadata ignores case", FilterCriteria{Metadata: map[string]string{"track": "dev"}}, 0, true},
+		{"metadata mismatch", FilterCriteria{Metadata: map[string]string{"track": "ops"}}, 0, false},
+		{"score in range", FilterCriteria{MinScore: 1600, MaxScore: 1700}, 0, true},
+		{"score below range", FilterCriteria{MinScore: 1700}, 0, false},
+		{"score above range", FilterCriteria{MaxScore: 1600}, 0, false},
+		{"confidence reached", FilterCriteria{MinConfidence: 50}, 75, true},
+		{"confidence too low", FilterCriteria{MinConfidence: 80}, 75, false},
+		{"any conflict tag", FilterCriteria{ConflictTags: []string{"globex", "acme"}}, 0, true},
+		{"no conflict tag", FilterCriteria{ConflictTags: []string{"globex"}}, 0, false},
+		{"any label ignores case", FilterCriteria{Labels: []string{"keynote", "beginner-friendly"}}, 0, true},
+		{"no label", FilterCriteria{Labels: []string{"keynote"}}, 0, false},
+		{"conflict tag without label", FilterCriteria{ConflictTags: []string{"acme"}, Labels: []string{"keynote"}}, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.Matches(proposal, tt.confidence); got != tt.expected {
+				t.Errorf("Matches() = %v, expected %v", got, tt.expected)
+			}
+			if tt.filter.IsActive() == (tt.name == "empty filter") {
+				t.Errorf("IsActive() = %v for %+v", tt.filter.IsActive(), tt.filter)
+			}
+		})
+	}
+}
+
+func TestRankingScreen_Search(t *testing.T) {
+	screen := NewRankingScreen()
+	if err := screen.OnEnter(newRankingMockApp()); err != nil {
+		t.Fatalf("OnEnter() failed: %v", err)
+	}
+
+	// Typing in the search box narrows the table while ranks stay those of all proposals
+	screen.showSearch()
+	screen.searchInput.SetText("go")
+	if len(screen.filtered) != 1 || screen.filtered[0].ID != "1" {
+		t.Fatalf("Expected only proposal 1 to match 'go', got %+v", screen.filtered)
+	}
+	if rows := screen.rankingTable.GetRowCount(); rows != 2 {
+		t.Errorf("Expected header and one row, got %d rows", rows)
+	}
+	if rank := screen.rankingTable.GetCell(1, 0).Text; rank != "2" {
+		t.Errorf("Expected proposal 1 to keep its overall rank 2, got %s", rank)
+	}
+	if status := screen.statusBar.GetText(true); !strings.Contains(status, `Filter: "go" (1 of 5 shown`) {
+		t.Errorf("Expected status bar to show the active filter, got %q", status)
+	}
+	ids, active := screen.FilteredProposalIDs()
+	if !active || len(ids) != 1 || ids[0] != "1" {
+		t.Errorf("Expected export of proposal 1 only, got %v (active %v)", ids, active)
+	}
+
+	// Enter keeps the search, Esc restores the one from before the box was opened
+	pressKey(screen.searchInput, tcell.KeyEnter)
+	if screen.searching {
+		t.Error("Expected Enter to close the search box")
+	}
+	screen.showSearch()
+	screen.searchInput.SetText("nothing matches")
+	if len(screen.filtered) != 0 {
+		t.Errorf("Expected no proposals to match, got %d", len(screen.filtered))
+	}
+	pressKey(screen.searchInput, tcell.KeyEscape)
+	if screen.filter.SearchText != "go" || len(screen.filtered) != 1 {
+		t.Errorf("Expected Esc to restore the search 'go', got %q with %d proposals", screen.filter.SearchText, len(screen.filtered))
+	}
+
+	screen.clearFilter()
+	if _, active := screen.FilteredProposalIDs(); active || len(screen.filtered) != 5 {
+		t.Errorf("Expected all proposals after clearing, got %d (active %v)", len(screen.filtered), active)
+	}
+}
+
+func TestRankingScreen_FilterPanel(t *testing.T) {
+	proposals := []data.Proposal{
+		{ID: "A", Title: "Alpha", Score: 1700, Metadata: map[string]string{"track": "dev"}},
+		{ID: "B", Title: "Beta", Score: 1650, Metadata: map[string]string{"track": "ops"}, ConflictTags: []string{"acme"}},
+		{ID: "C", Title: "Gamma", Score: 1600, Metadata: map[string]string{"track": "dev"}, ConflictTags: []string{"acme"}},
+		{ID: "D", Title: "Delta", Score: 1300, Metadata: map[string]string{"track": "dev"}},
+	}
+	screen := NewRankingScreen()
+	mockApp := newRankingMockApp()
+	mockApp.proposals = proposals
+	if err := screen.OnEnter(mockApp); err != nil {
+		t.Fatalf("OnEnter() failed: %v", err)
+	}
+
+	setField := func(label, text string) {
+		screen.filterForm.GetFormItemByLabel(label).(*tview.InputField).SetText(text)
+	}
+
+	// Invalid input keeps the panel open and the previous filter
+	screen.showFilterPanel()
+	setField(filterMinScoreLabel, "high")
+	screen.applyFilterPanel()
+	if !screen.filtering || screen.filter.IsActive() {
+		t.Error("Expected invalid filter to be rejected")
+	}
+	if status := screen.statusBar.GetText(true); !strings.Contains(status, "min score must be a number") {
+		t.Errorf("Expected status bar to report the invalid filter, got %q", status)
+	}
+
+	setField(filterMetadataLabel, "track=dev")
+	setField(filterMinScoreLabel, "1500")
+	setField(filterConflictsLabel, "acme")
+	screen.applyFilterPanel()
+	if screen.filtering {
+		t.Error("Expected the filter panel to close after applying")
+	}
+	if len(screen.filtered) != 1 || screen.filtered[0].ID != "C" {
+		t.Fatalf("Expected only C to pass the filter, got %+v", screen.filtered)
+	}
+	if status := screen.statusBar.GetText(true); !strings.Contains(status, "Filter: track=dev, score >= 1500, conflicts acme (1 of 4 shown") {
+		t.Errorf("Expected status bar to describe the filter, got %q", status)
+	}
+
+	// Reopening the panel shows the active filter
+	screen.showFilterPanel()
+	if text := screen.filterForm.GetFormItemByLabel(filterMinScoreLabel).(*tview.InputField).GetText(); text != "1500" {
+		t.Errorf("Expected the panel to show min score 1500, got %q", text)
+	}
+	setField(filterMaxScoreLabel, "1400")
+	screen.applyFilterPanel()
+	if screen.filter.MaxScore != 0 {
+		t.Error("Expected a max score below the min score to be rejected")
+	}
+	screen.hideFilterPanel()
+
+	// Esc on the table clears the filter
+	pressKey(screen.rankingTable, tcell.KeyEscape)
+	if screen.filter.IsActive() || len(screen.filtered) != 4 {
+		t.Errorf("Expected Esc to clear the filter, got %d proposals", len(screen.filtered))
+	}
+}
+
+func TestRankingScreen_FilterByLabels(t *testing.T) {
+	proposals := []data.Proposal{
+		{ID: "A", Title: "Alpha", Score: 1700},
+		{ID: "B", Title: "Beta", Score: 1650},
+		{ID: "C", Title: "Gamma", Score: 1600},
+	}
+	session, err := data.NewSession("Labels", proposals, data.DefaultSessionConfig(), "test.csv")
+	if err != nil {
+		t.Fatalf("NewSession() failed: %v", err)
+	}
+	if err := session.SetLabels("A", []string{"keynote"}); err != nil {
+		t.Fatalf("SetLabels() failed: %v", err)
+	}
+	if err := session.SetLabels("C", []string{"beginner-friendly", "keynote"}); err != nil {
+		t.Fatalf("SetLabels() failed: %v", err)
+	}
+
+	screen := NewRankingScreen()
+	if err := screen.OnEnter(&RankingMockAppWithSession{RankingMockApp: *newRankingMockApp(), session: session}); err != nil {
+		t.Fatalf("OnEnter() failed: %v", err)
+	}
+
+	screen.showFilterPanel()
+	screen.filterForm.GetFormItemByLabel(filterLabelsLabel).(*tview.InputField).SetText("Beginner-Friendly, beginner-friendly")
+	screen.applyFilterPanel()
+	if len(screen.filtered) != 1 || screen.filtered[0].ID != "C" {
+		t.Fatalf("Expected only C to carry the label, got %+v", screen.filtered)
+	}
+	if status := screen.statusBar.GetText(true); !strings.Contains(status, "Filter: labels Beginner-Friendly (1 of 3 shown") {
+		t.Errorf("Expected status bar to describe the label filter, got %q", status)
+	}
+
+	screen.showDetail("C")
+	if detail := screen.detailView.GetText(true); !strings.Contains(detail, "Labels: beginner-friendly, keynote") {
+		t.Errorf("Expected the detail pane to show the labels, got %q", detail)
+	}
+}
+
+// pressKey sends a key to a primitive through its input capture and handler
+func pressKey(primitive interface {
+	InputHandler() func(*tcell.EventKey, func(tview.Primitive))
+	GetInputCapture() func(*tcell.EventKey) *tcell.EventKey
+}, key tcell.Key) {
+	event := tcell.NewEventKey(key, 0, tcell.ModNone)
+	if capture := primitive.GetInputCapture(); capture != nil {
+		if event = capture(event); event == nil {
+			return
+		}
+	}
+	primitive.InputHandler()(event, func(tview.Primitive) {})
+}
+
+func TestRankingScreen_DetailPane(t *testing.T) {
+	proposals := []data.Proposal{
+		{ID: "A", Title: "Alpha", Speaker: "Ann", Abstract: "All about alpha.", Score: 1500,
+			Metadata: map[string]string{"track": "dev", "level": "advanced"}, ConflictTags: []string{"acme"}},
+		{ID: "B", Title: "Beta", Score: 1500},
+		{ID: "C", Title: "Gamma", Score: 1500},
+	}
+	session, err := data.NewSession("Details", proposals, data.DefaultSessionConfig(), "test.csv")
+	if err != nil {
+		t.Fatalf("NewSession() failed: %v", err)
+	}
+	record := func(id, winner, loser string, winnerOld, loserOld float64) {
+		err := session.RecordComparison(data.Comparison{
+			ID:          id,
+			ProposalIDs: []string{winner, loser},
+			WinnerID:    winner,
+			Method:      data.MethodPairwise,
+			EloUpdates: []data.EloUpdate{
+				{ComparisonID: id, ProposalID: winner, OldRating: winnerOld, NewRating: winnerOld + 16, RatingDelta: 16},
+				{ComparisonID: id, ProposalID: loser, OldRating: loserOld, NewRating: loserOld - 16, RatingDelta: -16},
+			},
+		})
+		if err != nil {
+			t.Fatalf("RecordComparison() failed: %v", err)
+		}
+	}
+	record("c1", "A", "B", 1500, 1500)
+	record("c2", "C", "A", 1500, 1516)
+	record("c3", "A", "C", 1500, 1516)
+
+	screen := NewRankingScreen()
+	mockApp := &RankingMockAppWithSession{RankingMockApp: *newRankingMockApp(), session: session}
+	if err := screen.OnEnter(mockApp); err != nil {
+		t.Fatalf("OnEnter() failed: %v", err)
+	}
+
+	// Ranked A (1516), C (1500), B (1484); Enter on the first row opens A
+	screen.rankingTable.Select(1, 0)
+	pressKey(screen.rankingTable, tcell.KeyEnter)
+	if screen.detailID != "A" {
+		t.Fatalf("Expected details of A, got %q", screen.detailID)
+	}
+
+	text := screen.detailView.GetText(true)
+	for _, want := range []string{
+		"Alpha", "Ann", "Rank: 1 of 3", "All about alpha.", "level: advanced", "track: dev", "Conflicts: acme",
+		"Elo rating history: ▁█▁█ 1500 → 1516", "Comparisons: 3 (2 won, 1 lost, 0 drawn)",
+		"won   vs Beta", "lost  vs Gamma", "won   vs Gamma",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Expected details to contain %q, got:\n%s", want, text)
+		}
+	}
+
+	// Neighbours follow the rank order and move the table selection along
+	pressKey(screen.detailView, tcell.KeyRight)
+	if screen.detailID != "C" {
+		t.Errorf("Expected next-ranked C, got %q", screen.detailID)
+	}
+	screen.showNeighbour(1)
+	if row, _ := screen.rankingTable.GetSelection(); screen.detailID != "B" || row != 3 {
+		t.Errorf("Expected B on row 3, got %q on row %d", screen.detailID, row)
+	}
+	screen.showNeighbour(1)
+	if screen.detailID != "B" {
+		t.Errorf("Expected the last proposal to stay shown, got %q", screen.detailID)
+	}
+	if text := screen.detailView.GetText(true); !strings.Contains(text, "lost  vs Alpha") {
+		t.Errorf("Expected B's loss against Alpha, got:\n%s", text)
+	}
+
+	pressKey(screen.detailView, tcell.KeyEscape)
+	if screen.detailID != "" || screen.mainLayout.GetItemCount() != 1 {
+		t.Error("Expected Esc to close the detail pane")
+	}
+}
+
+func TestRankingScreen_Notes(t *testing.T) {
+	proposals := []data.Proposal{
+		{ID: "A", Title: "Alpha", Score: 1600},
+		{ID: "B", Title: "Beta", Score: 1500},
+	}
+	session, err := data.NewSession("Notes", proposals, data.DefaultSessionConfig(), "test.csv")
+	if err != nil {
+		t.Fatalf("NewSession() failed: %v", err)
+	}
+	err = session.RecordComparison(data.Comparison{
+		ID:          "c1",
+		ProposalIDs: []string{"A", "B"},
+		WinnerID:    "A",
+		Method:      data.MethodPairwise,
+		Note:        "Clearer takeaway",
+	})
+	if err != nil {
+		t.Fatalf("RecordComparison() failed: %v", err)
+	}
+
+	screen := NewRankingScreen()
+	if err := screen.OnEnter(&RankingMockAppWithSession{RankingMockApp: *newRankingMockApp(), session: session}); err != nil {
+		t.Fatalf("OnEnter() failed: %v", err)
+	}
+	pressRune := func(capture func(*tcell.EventKey) *tcell.EventKey, key rune) {
+		capture(tcell.NewEventKey(tcell.KeyRune, key, tcell.ModNone))
+	}
+
+	// 'a' on a row edits the note of its proposal
+	screen.rankingTable.Select(2, 0)
+	pressRune(screen.rankingTable.GetInputCapture(), 'a')
+	if !screen.editingNote || screen.mainLayout.GetItemCount() != 2 {
+		t.Fatal("Expected 'a' to open the note editor beside the rankings")
+	}
+	screen.noteEditor.SetText("Needs a co-speaker")
+	screen.noteEditor.Save()
+	if screen.editingNote || screen.mainLayout.GetItemCount() != 1 {
+		t.Error("Expected saving to close the note editor")
+	}
+	if got := session.GetNote("B"); got != "Needs a co-speaker" {
+		t.Errorf("Expected note on B, got %q", got)
+	}
+
+	// The detail pane shows the note and why comparisons went the way they did
+	screen.showDetail("B")
+	text := screen.detailView.GetText(true)
+	for _, want := range []string{"Note: Needs a co-speaker", "lost  vs Alpha", "why: Clearer takeaway"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Expected details to contain %q, got:\n%s", want, text)
+		}
+	}
+
+	// 'a' in the detail pane edits the shown proposal; cancelling keeps the note
+	pressRune(screen.detailView.GetInputCapture(), 'a')
+	if got := screen.noteEditor.Text(); got != "Needs a co-speaker" {
+		t.Errorf("Expected the editor to start from the note, got %q", got)
+	}
+	screen.noteEditor.SetText("discarded")
+	screen.noteEditor.Cancel()
+	if got := session.GetNote("B"); got != "Needs a co-speaker" {
+		t.Errorf("Expected cancel to keep the note, got %q", got)
+	}
+}
+
+func TestSparkline(t *testing.T) {
+	if got := sparkline([]float64{1500, 1516, 1484, 1532}, 40); got != "▃▅▁█" {
+		t.Errorf("sparkline() = %q, expected ▃▅▁█", got)
+	}
+	if got := sparkline([]float64{1, 2, 3, 4}, 2); got != "▁█" {
+		t.Errorf("Expected only the last two values, got %q", got)
+	}
+	if got := sparkline([]float64{1500, 1500}, 40); got != "▅▅" {
+		t.Errorf("Expected flat values mid-height, got %q", got)
+	}
+}
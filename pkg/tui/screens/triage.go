@@ -0,0 +1,292 @@
+// Package screens provides TUI screen implementations for conference talk ranking.
+// This file implements the triage screen where users quickly bin each proposal as
+// strong, maybe or weak before spending comparisons on it.
+package screens
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+
+	"github.com/pashagolub/confelo/pkg/data"
+)
+
+// TriageScreen shows one proposal at a time and records its triage bucket
+type TriageScreen struct {
+	// UI components
+	container    *tview.Flex
+	card         *tview.TextView
+	controlPanel *tview.TextView
+	statusBar    *tview.TextView
+
+	// Triage state
+	proposals []data.Proposal // Proposals the reviewer is not conflicted with, in session order
+	current   int             // Index of the shown proposal
+
+	// App reference - we'll use any and cast as needed
+	app any
+}
+
+// NewTriageScreen creates a new triage screen instance
+func NewTriageScreen() *TriageScreen {
+	ts := &TriageScreen{
+		container:    tview.NewFlex(),
+		card:         tview.NewTextView(),
+		controlPanel: tview.NewTextView(),
+		statusBar:    tview.NewTextView(),
+	}
+
+	ts.setupUI()
+	return ts
+}
+
+// setupUI initializes the triage screen layout
+func (ts *TriageScreen) setupUI() {
+	ts.card.SetBorder(true).
+		SetTitle("Proposal").
+		SetBorderColor(tcell.ColorBlue)
+	ts.card.SetWordWrap(true)
+	ts.card.SetDynamicColors(true)
+
+	ts.controlPanel.
+		SetBorder(true).
+		SetTitle("Triage")
+	ts.controlPanel.SetWordWrap(true)
+	ts.controlPanel.SetDynamicColors(true)
+	ts.controlPanel.SetText(`[yellow::b]Bin each proposal[white::-]
+
+[green]1[white] - Strong
+[green]2[white] - Maybe
+[green]3[white] - Weak
+[green]0[white] - Clear bucket
+
+[green]n / →[white] - Next proposal
+[green]p / ←[white] - Previous proposal
+[green]x[white] - Exclude weak proposals from matchups (toggle)
+
+[dim]Buckets seed the starting rating of proposals that were not compared yet.
+Press c to start comparing.[-]`)
+
+	ts.statusBar.
+		SetBorder(true).
+		SetTitle("Status")
+	ts.statusBar.SetDynamicColors(true)
+
+	rightPanel := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(ts.controlPanel, 0, 1, false).
+		AddItem(ts.statusBar, 4, 0, false)
+
+	ts.container.SetDirection(tview.FlexColumn).
+		AddItem(ts.card, 0, 75, true).
+		AddItem(rightPanel, 0, 25, false)
+
+	ts.container.SetInputCapture(ts.handleInput)
+}
+
+// GetPrimitive returns the main container primitive
+func (ts *TriageScreen) GetPrimitive() tview.Primitive {
+	return ts.container
+}
+
+// OnEnter is called when the screen becomes active
+func (ts *TriageScreen) OnEnter(app any) error {
+	ts.app = app
+
+	session := ts.getSession()
+	if session == nil {
+		return fmt.Errorf("no active session")
+	}
+
+	ts.proposals = ts.proposals[:0]
+	for _, proposal := range session.GetProposals() {
+		if !session.IsConflicted(proposal.ID) {
+			ts.proposals = append(ts.proposals, proposal)
+		}
+	}
+
+	// Resume at the first proposal without a bucket
+	ts.current = 0
+	ts.advance(session)
+
+	ts.updateDisplay()
+	return nil
+}
+
+// OnExit is called when leaving the screen
+func (ts *TriageScreen) OnExit(app any) error {
+	return nil
+}
+
+// GetTitle returns the screen title
+func (ts *TriageScreen) GetTitle() string {
+	return "Triage"
+}
+
+// handleInput processes keyboard input for the triage screen
+func (ts *TriageScreen) handleInput(event *tcell.EventKey) *tcell.EventKey {
+	switch event.Key() {
+	case tcell.KeyRight:
+		ts.move(1)
+		return nil
+	case tcell.KeyLeft:
+		ts.move(-1)
+		return nil
+	}
+
+	switch event.Rune() {
+	case '1':
+		ts.setBucket(data.BucketStrong)
+		return nil
+	case '2':
+		ts.setBucket(data.BucketMaybe)
+		return nil
+	case '3':
+		ts.setBucket(data.BucketWeak)
+		return nil
+	case '0':
+		ts.setBucket(data.BucketNone)
+		return nil
+	case 'n':
+		ts.move(1)
+		return nil
+	case 'p':
+		ts.move(-1)
+		return nil
+	case 'x':
+		ts.toggleExcludeWeak()
+		return nil
+	}
+
+	return event
+}
+
+// setBucket records the bucket of the shown proposal and moves on to the next one without a bucket
+func (ts *TriageScreen) setBucket(bucket data.Bucket) {
+	session := ts.getSession()
+	if session == nil || len(ts.proposals) == 0 {
+		return
+	}
+
+	id := ts.proposals[ts.current].ID
+	if err := session.SetBucket(id, bucket); err != nil {
+		ts.statusBar.SetText(fmt.Sprintf("[red]Error: %v[-]", err))
+		return
+	}
+
+	// Pick up the seeded rating
+	if proposal, err := session.GetProposalByID(id); err == nil {
+		ts.proposals[ts.current] = *proposal
+	}
+	ts.publishSession(session)
+
+	if bucket != data.BucketNone {
+		ts.advance(session)
+	}
+	ts.updateDisplay()
+}
+
+// advance moves to the next proposal without a bucket, starting at the shown one and
+// wrapping around; the shown proposal stays when every proposal has a bucket
+func (ts *TriageScreen) advance(session *data.Session) {
+	for i := range ts.proposals {
+		next := (ts.current + i) % len(ts.proposals)
+		if session.GetBucket(ts.proposals[next].ID) == data.BucketNone {
+			ts.current = next
+			return
+		}
+	}
+}
+
+// move shows the next (1) or previous (-1) proposal, wrapping around
+func (ts *TriageScreen) move(step int) {
+	if len(ts.proposals) == 0 {
+		return
+	}
+	ts.current = (ts.current + step + len(ts.proposals)) % len(ts.proposals)
+	ts.updateDisplay()
+}
+
+// toggleExcludeWeak switches whether weak proposals are kept out of further matchups
+func (ts *TriageScreen) toggleExcludeWeak() {
+	session := ts.getSession()
+	if session == nil {
+		return
+	}
+	session.SetExcludeWeak(!session.ExcludesWeak())
+	ts.publishSession(session)
+	ts.updateStatus()
+}
+
+// updateDisplay refreshes the proposal card and the status bar
+func (ts *TriageScreen) updateDisplay() {
+	if len(ts.proposals) == 0 {
+		ts.card.SetTitle("Proposal")
+		ts.card.SetText("[dim]No proposals to triage[-]")
+		ts.updateStatus()
+		return
+	}
+
+	proposal := ts.proposals[ts.current]
+	ts.card.SetTitle(fmt.Sprintf("Proposal %d of %d", ts.current+1, len(ts.proposals)))
+
+	var content strings.Builder
+	content.WriteString(fmt.Sprintf("[white::b]%s[white::-]\n\n", tview.Escape(proposal.Title)))
+	if proposal.Speaker != "" {
+		content.WriteString(fmt.Sprintf("[yellow]Speaker:[-] %s\n\n", tview.Escape(proposal.Speaker)))
+	}
+	if proposal.Abstract != "" {
+		content.WriteString(fmt.Sprintf("[green]Abstract:[-]\n%s\n\n", tview.Escape(proposal.Abstract)))
+	}
+	content.WriteString(fmt.Sprintf("[blue]Current Rating:[-] %.0f", proposal.Score))
+
+	bucket := data.BucketNone
+	if session := ts.getSession(); session != nil {
+		bucket = session.GetBucket(proposal.ID)
+	}
+	if bucket != data.BucketNone {
+		content.WriteString(fmt.Sprintf("\n[blue]Bucket:[-] %s", bucket))
+	} else {
+		content.WriteString("\n[blue]Bucket:[-] [dim]none[-]")
+	}
+
+	ts.card.SetText(content.String())
+	ts.card.ScrollToBeginning()
+	ts.updateStatus()
+}
+
+// updateStatus shows how many proposals are in each bucket
+func (ts *TriageScreen) updateStatus() {
+	session := ts.getSession()
+	if session == nil {
+		return
+	}
+
+	counts := make(map[data.Bucket]int)
+	for _, proposal := range ts.proposals {
+		counts[session.GetBucket(proposal.ID)]++
+	}
+
+	status := fmt.Sprintf("Strong: %d | Maybe: %d | Weak: %d | Untriaged: %d",
+		counts[data.BucketStrong], counts[data.BucketMaybe], counts[data.BucketWeak], counts[data.BucketNone])
+	if session.ExcludesWeak() {
+		status += "\n[yellow]Weak proposals excluded from matchups[-]"
+	}
+	ts.statusBar.SetText(status)
+}
+
+// publishSession hands the updated session back to the app
+func (ts *TriageScreen) publishSession(session *data.Session) {
+	if app, ok := ts.app.(interface{ SetSession(*data.Session) }); ok {
+		app.SetSession(session)
+	}
+}
+
+// getSession gets the current session from the app
+func (ts *TriageScreen) getSession() *data.Session {
+	if app, ok := ts.app.(interface{ GetSession() *data.Session }); ok {
+		return app.GetSession()
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package screens
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+
+	"github.com/pashagolub/confelo/pkg/data"
+)
+
+// newTriageTestScreen creates an entered triage screen on a session of four proposals
+func newTriageTestScreen(t *testing.T) (*TriageScreen, *data.Session) {
+	proposals := []data.Proposal{
+		{ID: "A", Title: "Alpha", Score: 1500},
+		{ID: "B", Title: "Beta", Score: 1500, ConflictTags: []string{"acme"}},
+		{ID: "C", Title: "Gamma", Score: 1500},
+		{ID: "D", Title: "Delta", Score: 1500},
+	}
+	session, err := data.NewSession("Triage", proposals, data.DefaultSessionConfig(), "test.csv")
+	if err != nil {
+		t.Fatalf("NewSession() failed: %v", err)
+	}
+	session.SetConflicts([]string{"acme"})
+
+	screen := NewTriageScreen()
+	if err := screen.OnEnter(&RankingMockAppWithSession{RankingMockApp: *newRankingMockApp(), session: session}); err != nil {
+		t.Fatalf("OnEnter() failed: %v", err)
+	}
+	return screen, session
+}
+
+// pressTriageRunes sends key presses to the triage screen
+func pressTriageRunes(screen *TriageScreen, keys string) {
+	for _, key := range keys {
+		screen.handleInput(tcell.NewEventKey(tcell.KeyRune, key, tcell.ModNone))
+	}
+}
+
+func TestTriageScreen_Buckets(t *testing.T) {
+	screen, session := newTriageTestScreen(t)
+
+	if screen.GetTitle() != "Triage" {
+		t.Errorf("Expected title 'Triage', got %q", screen.GetTitle())
+	}
+	if len(screen.proposals) != 3 {
+		t.Fatalf("Expected the conflicted proposal to be left out, got %d proposals", len(screen.proposals))
+	}
+
+	// Each bucket moves on to the next proposal without one
+	pressTriageRunes(screen, "13")
+	if got := session.GetBucket("A"); got != data.BucketStrong {
+		t.Errorf("Expected A to be strong, got %q", got)
+	}
+	if got := session.GetBucket("C"); got != data.BucketWeak {
+		t.Errorf("Expected C to be weak, got %q", got)
+	}
+	if got := screen.proposals[screen.current].ID; got != "D" {
+		t.Errorf("Expected D to be shown next, got %s", got)
+	}
+
+	proposal, _ := session.GetProposalByID("A")
+	if proposal.Score != 2250 {
+		t.Errorf("Expected strong bucket to seed a rating of 2250, got %.0f", proposal.Score)
+	}
+	if !strings.Contains(screen.statusBar.GetText(true), "Strong: 1 | Maybe: 0 | Weak: 1 | Untriaged: 1") {
+		t.Errorf("Unexpected status %q", screen.statusBar.GetText(true))
+	}
+
+	// Going back and clearing keeps the proposal shown
+	pressTriageRunes(screen, "p0")
+	if got := session.GetBucket("C"); got != data.BucketNone {
+		t.Errorf("Expected C to be cleared, got %q", got)
+	}
+	if got := screen.proposals[screen.current].ID; got != "C" {
+		t.Errorf("Expected C to stay shown, got %s", got)
+	}
+	screen.handleInput(tcell.NewEventKey(tcell.KeyRight, 0, tcell.ModNone))
+	if got := screen.proposals[screen.current].ID; got != "D" {
+		t.Errorf("Expected right arrow to show D, got %s", got)
+	}
+}
+
+func TestTriageScreen_ExcludeWeak(t *testing.T) {
+	screen, session := newTriageTestScreen(t)
+
+	pressTriageRunes(screen, "3x")
+	if !session.ExcludesWeak() {
+		t.Fatal("Expected 'x' to exclude weak proposals")
+	}
+	for _, proposal := range session.GetReviewableProposals() {
+		if proposal.ID == "A" {
+			t.Error("Expected weak proposal A to be left out of matchups")
+		}
+	}
+	if !strings.Contains(screen.statusBar.GetText(true), "excluded") {
+		t.Errorf("Expected status to mention the exclusion, got %q", screen.statusBar.GetText(true))
+	}
+
+	pressTriageRunes(screen, "x")
+	if session.ExcludesWeak() {
+		t.Error("Expected a second 'x' to include weak proposals again")
+	}
+}